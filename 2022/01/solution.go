@@ -0,0 +1,47 @@
+// https://adventofcode.com/2022/day/1
+
+package day01
+
+import (
+	"strconv"
+
+	"github.com/aurelbec/advent-of-code/aoc"
+	"github.com/aurelbec/advent-of-code/utils"
+)
+
+// parseCalories returns the calories carried by each elf
+func parseCalories(inputs []string) []int {
+	// every section lists the calories of the food carried by an elf
+	sums := utils.Must(utils.MapSections(inputs, func(section utils.Section) (int, error) {
+		sum := 0
+		for i, food := range section.Lines {
+			calories, err := strconv.Atoi(food)
+			if err != nil {
+				return 0, section.Errorf(i, "invalid calories %q", food)
+			}
+			sum += calories
+		}
+		return sum, nil
+	}))
+
+	return sums
+}
+
+func part1(sums []int) int {
+	return utils.Largest(sums, 1)[0]
+}
+
+func part2(sums []int) int {
+	return utils.Sum(utils.Largest(sums, 3))
+}
+
+func init() {
+	aoc.Register(aoc.Puzzle[[]int, int, int]{
+		Year:  2022,
+		Day:   1,
+		Title: "Calorie Counting",
+		Parse: aoc.Lines(parseCalories),
+		Part1: part1,
+		Part2: part2,
+	})
+}
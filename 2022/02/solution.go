@@ -1,12 +1,11 @@
 // https://adventofcode.com/2022/day/2
 
-package main
+package day02
 
 import (
-	"fmt"
 	"strings"
-	"time"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 )
 
@@ -65,29 +64,22 @@ func play(i, n int) int {
 	return ((i % n) + n) % n
 }
 
-func main() {
-	fmt.Println("--- 2022 Day 2: Rock Paper Scissors ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	////////////////////////////////////////
+// parseRounds returns the columns of the strategy guide, for each round
+func parseRounds(inputs []string) [][]string {
+	return utils.ArrayMap(inputs, strings.Fields)
+}
 
+func part1(rounds [][]string) int {
 	score := 0
-	for _, input := range inputs {
-		plays := strings.Fields(input)
+	for _, plays := range rounds {
 		score += points(convert(plays[0]), convert(plays[1]))
 	}
+	return score
+}
 
-	// 15
-	fmt.Println("Part 1:", score)
-
-	////////////////////////////////////////
-
-	score = 0
-	for _, input := range inputs {
-		plays := strings.Fields(input)
+func part2(rounds [][]string) int {
+	score := 0
+	for _, plays := range rounds {
 		switch plays[1] {
 		case "X": // need to lose
 			score += points(convert(plays[0]), play(convert(plays[0])-1, 3))
@@ -97,7 +89,16 @@ func main() {
 			score += points(convert(plays[0]), play(convert(plays[0])+1, 3))
 		}
 	}
+	return score
+}
 
-	// 12
-	fmt.Println("Part 2:", score)
+func init() {
+	aoc.Register(aoc.Puzzle[[][]string, int, int]{
+		Year:  2022,
+		Day:   2,
+		Title: "Rock Paper Scissors",
+		Parse: aoc.Lines(parseRounds),
+		Part1: part1,
+		Part2: part2,
+	})
 }
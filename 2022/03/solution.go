@@ -0,0 +1,63 @@
+// https://adventofcode.com/2022/day/3
+
+package day03
+
+import (
+	"github.com/aurelbec/advent-of-code/aoc"
+	"github.com/aurelbec/advent-of-code/utils"
+)
+
+// getItems returns the set of all items contained in a compartment
+func getItems(compartment string) utils.Set[rune] {
+	return utils.NewSet([]rune(compartment)...)
+}
+
+// getCommon return the first common element between compartments
+func getCommon(compartments ...string) rune {
+	for common := range utils.Intersection(utils.ArrayMap(compartments, getItems)...) {
+		return common
+	}
+	return -1
+}
+
+// getPriority returns the item priority
+func getPriority(item rune) int {
+	switch {
+	case 'a' <= item && item <= 'z':
+		return int(item-'a') + 1
+	case 'A' <= item && item <= 'Z':
+		return int(item-'A') + 27
+	default:
+		return -1
+	}
+}
+
+func part1(rucksacks []string) int {
+	priorities := 0
+	for _, rucksack := range rucksacks {
+		sep := len(rucksack) / 2
+		common := getCommon(rucksack[:sep], rucksack[sep:])
+		priorities += getPriority(common)
+	}
+	return priorities
+}
+
+func part2(rucksacks []string) int {
+	priorities := 0
+	for i := 0; i < len(rucksacks); i += 3 {
+		common := getCommon(rucksacks[i], rucksacks[i+1], rucksacks[i+2])
+		priorities += getPriority(common)
+	}
+	return priorities
+}
+
+func init() {
+	aoc.Register(aoc.Puzzle[[]string, int, int]{
+		Year:  2022,
+		Day:   3,
+		Title: "Rucksack Reorganization",
+		Parse: func(input aoc.Input) []string { return input.Lines },
+		Part1: part1,
+		Part2: part2,
+	})
+}
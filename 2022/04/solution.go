@@ -1,11 +1,11 @@
 // https://adventofcode.com/2022/day/4
 
-package main
+package day04
 
 import (
 	"fmt"
-	"time"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 )
 
@@ -18,36 +18,33 @@ func parseAssignments(inputs []string) (assignments [][2]utils.Interval[int]) {
 	return
 }
 
-func main() {
-	fmt.Println("--- 2022 Day 4: Camp Cleanup ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	assignments := parseAssignments(inputs)
-
-	////////////////////////////////////////
-
+func part1(assignments [][2]utils.Interval[int]) int {
 	fullyContained := 0
 	for _, assignment := range assignments {
 		if assignment[0].Contains(assignment[1]) || assignment[1].Contains(assignment[0]) {
 			fullyContained++
 		}
 	}
+	return fullyContained
+}
 
-	// 2
-	fmt.Println("Part 1:", fullyContained)
-
-	////////////////////////////////////////
-
+func part2(assignments [][2]utils.Interval[int]) int {
 	overlapsAtAll := 0
 	for _, assignment := range assignments {
 		if assignment[0].Overlaps(assignment[1]) {
 			overlapsAtAll++
 		}
 	}
+	return overlapsAtAll
+}
 
-	// 4
-	fmt.Println("Part 2:", overlapsAtAll)
+func init() {
+	aoc.Register(aoc.Puzzle[[][2]utils.Interval[int], int, int]{
+		Year:  2022,
+		Day:   4,
+		Title: "Camp Cleanup",
+		Parse: aoc.Lines(parseAssignments),
+		Part1: part1,
+		Part2: part2,
+	})
 }
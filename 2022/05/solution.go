@@ -1,12 +1,12 @@
 // https://adventofcode.com/2022/day/5
 
-package main
+package day05
 
 import (
 	"fmt"
 	"strings"
-	"time"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 )
 
@@ -52,42 +52,44 @@ func firstChar(s string, _ ...int) string {
 	return string(s[0])
 }
 
-func main() {
-	fmt.Println("--- 2022 Day 5: Supply Stacks ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	sep := 0
-	for ; inputs[sep] != ""; sep++ {
-	}
-
-	moves := getMoves(inputs[sep+1:])
-
-	////////////////////////////////////////
+type Procedure struct {
+	stacks []string
+	moves  [][3]int
+}
 
-	// separate stacks description and rearrangement procedure
+// parseProcedure separates stacks description and rearrangement procedure
+func parseProcedure(inputs []string) Procedure {
+	sections := utils.Must(utils.SplitSections(inputs, "stacks", "moves"))
+	return Procedure{stacks: getStacks(sections.Get("stacks").Lines), moves: getMoves(sections.Get("moves").Lines)}
+}
 
-	stacks := getStacks(inputs[:sep])
-	for _, move := range moves {
+func part1(procedure Procedure) string {
+	stacks := procedure.stacks
+	for _, move := range procedure.moves {
 		n, from, to := move[0], move[1]-1, move[2]-1
 		stacks[to] = reverse(stacks[from][:n]) + stacks[to]
 		stacks[from] = stacks[from][n:]
 	}
+	return utils.SumFunc(stacks, firstChar)
+}
 
-	// CMZ
-	fmt.Println("Part 1:", utils.SumFunc(stacks, firstChar))
-
-	////////////////////////////////////////
-
-	stacks = getStacks(inputs[:sep])
-	for _, move := range moves {
+func part2(procedure Procedure) string {
+	stacks := procedure.stacks
+	for _, move := range procedure.moves {
 		n, from, to := move[0], move[1]-1, move[2]-1
 		stacks[to] = stacks[from][:n] + stacks[to]
 		stacks[from] = stacks[from][n:]
 	}
+	return utils.SumFunc(stacks, firstChar)
+}
 
-	// MCD
-	fmt.Println("Part 2:", utils.SumFunc(stacks, firstChar))
+func init() {
+	aoc.Register(aoc.Puzzle[Procedure, string, string]{
+		Year:  2022,
+		Day:   5,
+		Title: "Supply Stacks",
+		Parse: aoc.Lines(parseProcedure),
+		Part1: part1,
+		Part2: part2,
+	})
 }
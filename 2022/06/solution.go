@@ -0,0 +1,44 @@
+// https://adventofcode.com/2022/day/6
+
+package day06
+
+import (
+	"strings"
+
+	"github.com/aurelbec/advent-of-code/aoc"
+)
+
+// hasDuplicates return whether a character is present more than once in a string
+func hasDuplicates(s string) bool {
+	for _, r := range s {
+		if strings.Count(s, string(r)) > 1 {
+			return true
+		}
+	}
+	return false
+}
+
+func getBuffer(input string, window int) (buffer int) {
+	for buffer = window; buffer < len(input)-1 && hasDuplicates(input[buffer-window:buffer]); buffer++ {
+	}
+	return
+}
+
+func part1(buffer string) int {
+	return getBuffer(buffer, 4)
+}
+
+func part2(buffer string) int {
+	return getBuffer(buffer, 14)
+}
+
+func init() {
+	aoc.Register(aoc.Puzzle[string, int, int]{
+		Year:  2022,
+		Day:   6,
+		Title: "Tuning Trouble",
+		Parse: func(input aoc.Input) string { return input.Lines[0] },
+		Part1: part1,
+		Part2: part2,
+	})
+}
@@ -1,12 +1,11 @@
 // https://adventofcode.com/2022/day/7
 
-package main
+package day07
 
 import (
 	"fmt"
-	"strings"
-	"time"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 )
 
@@ -47,13 +46,14 @@ func (d *directory) cd(dir string) *directory {
 
 func (d *directory) copy(items []string) {
 	for _, item := range items {
-		if strings.HasPrefix(item, "dir ") { // register dir
-			d.cd(item[4:])
-		} else { //register file
-			var size int
-			var name string
-			fmt.Sscanf(item, "%v %s", &size, &name)
+		var size int
+		var name string
+		if utils.Scan(item, "dir {name}", &name) == nil { // register dir
+			d.cd(name)
+		} else if err := utils.Scan(item, "{size} {name}", &size, &name); err == nil { // register file
 			d.files[name] = size
+		} else {
+			panic(fmt.Errorf("invalid item %q: %w", item, err))
 		}
 	}
 }
@@ -116,13 +116,8 @@ func newDirectory() *directory {
 	}
 }
 
-func main() {
-	fmt.Println("--- 2022 Day 7: No Space Left On Device ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
+// parseFilesystem replays the terminal output, and returns the root directory
+func parseFilesystem(inputs []string) *directory {
 	pwd := newDirectory()
 	for i := 0; i < len(inputs); i++ {
 		switch inputs[i][2:4] {
@@ -137,34 +132,41 @@ func main() {
 			i--
 		}
 	}
-	pwd = pwd.cd("/")
-
-	////////////////////////////////////////
+	return pwd.cd("/")
+}
 
+func part1(pwd *directory) int {
 	size := 0
 	pwd.walk(func(d *directory) {
 		if s := d.size(); s < 100_000 {
 			size += s
 		}
 	})
+	return size
+}
 
-	// 95437
-	fmt.Println("Part 1:", size)
-
-	////////////////////////////////////////
-
+func part2(pwd *directory) int {
 	max := 70_000_000
 	current := pwd.size()
 	remain := max - current
 	need := 30_000_000 - remain
 
-	size = max
+	size := max
 	pwd.walk(func(d *directory) {
 		if s := d.size(); s > need && s < size {
 			size = s
 		}
 	})
+	return size
+}
 
-	// 24933642
-	fmt.Println("Part 2:", size)
+func init() {
+	aoc.Register(aoc.Puzzle[*directory, int, int]{
+		Year:  2022,
+		Day:   7,
+		Title: "No Space Left On Device",
+		Parse: aoc.Lines(parseFilesystem),
+		Part1: part1,
+		Part2: part2,
+	})
 }
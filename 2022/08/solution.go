@@ -0,0 +1,73 @@
+// https://adventofcode.com/2022/day/8
+
+package day08
+
+import (
+	"github.com/aurelbec/advent-of-code/aoc"
+	"github.com/aurelbec/advent-of-code/utils"
+)
+
+// look walks from the tree toward the direction, and returns the number of trees seen from it
+func look(forest utils.Grid[int], location, direction utils.Location2D[int]) (viewingDistance int) {
+	height := forest.At(location)
+	for next := location.MovedBy(direction.X, direction.Y); forest.InBounds(next); next = next.MovedBy(direction.X, direction.Y) {
+		viewingDistance++
+		if forest.At(next) >= height {
+			break
+		}
+	}
+	return viewingDistance
+}
+
+// markVisible walks a line of the forest from the border toward the direction, and marks the trees visible from that
+// border, that is the ones taller than all the trees before them
+func markVisible(forest utils.Grid[int], visible utils.Grid[bool], border, direction utils.Location2D[int]) {
+	tallest := -1
+	for location := border; forest.InBounds(location); location = location.MovedBy(direction.X, direction.Y) {
+		if height := forest.At(location); height > tallest {
+			visible.Set(location, true)
+			tallest = height
+		}
+	}
+}
+
+// parseForest returns the heights of the trees of the forest
+func parseForest(inputs []string) utils.Grid[int] {
+	return utils.ParseGrid(inputs, func(b byte) int { return int(b - '0') })
+}
+
+func part1(forest utils.Grid[int]) int {
+	visible := utils.NewGrid[bool](forest.Width, forest.Height)
+	for y := 0; y < forest.Height; y++ {
+		markVisible(forest, visible, utils.NewLocation2D(0, y), utils.NewLocation2D(1, 0))
+		markVisible(forest, visible, utils.NewLocation2D(forest.Width-1, y), utils.NewLocation2D(-1, 0))
+	}
+	for x := 0; x < forest.Width; x++ {
+		markVisible(forest, visible, utils.NewLocation2D(x, 0), utils.NewLocation2D(0, 1))
+		markVisible(forest, visible, utils.NewLocation2D(x, forest.Height-1), utils.NewLocation2D(0, -1))
+	}
+	return visible.Count(func(visible bool) bool { return visible })
+}
+
+func part2(forest utils.Grid[int]) int {
+	viewingScore := 0
+	forest.Each(func(location utils.Location2D[int], _ int) {
+		score := 1
+		for _, direction := range utils.Directions4 {
+			score *= look(forest, location, direction)
+		}
+		viewingScore = max(viewingScore, score)
+	})
+	return viewingScore
+}
+
+func init() {
+	aoc.Register(aoc.Puzzle[utils.Grid[int], int, int]{
+		Year:  2022,
+		Day:   8,
+		Title: "Treetop Tree House",
+		Parse: aoc.Lines(parseForest),
+		Part1: part1,
+		Part2: part2,
+	})
+}
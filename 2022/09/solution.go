@@ -1,12 +1,11 @@
 // https://adventofcode.com/2022/day/9
 
-package main
+package day09
 
 import (
 	"fmt"
-	"time"
 
-	"github.com/aurelbec/advent-of-code/utils"
+	"github.com/aurelbec/advent-of-code/aoc"
 )
 
 // displacement needed to move closer to target the center
@@ -94,39 +93,37 @@ func parseMotionMove(motionMove string) (direction string, step int) {
 	return
 }
 
-func main() {
-	fmt.Println("--- 2022 Day 9: Rope Bridge ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs1 := utils.MustReadInput("example1.txt")
-	inputs2 := utils.MustReadInput("example2.txt")
-
-	////////////////////////////////////////
-
+func part1(motionMoves []string) int {
 	head := knot{x: 0, y: 0}
 	tail := knot{x: 0, y: 0}
-	for _, input := range inputs1 {
-		direction, step := parseMotionMove(input)
+	for _, motionMove := range motionMoves {
+		direction, step := parseMotionMove(motionMove)
 		for i := 0; i < step; i++ {
 			head.move(direction)
 			tail.moveCloserTo(head)
 		}
 	}
+	return tail.visits()
+}
 
-	// 13
-	fmt.Println("Part 1:", tail.visits())
-
-	////////////////////////////////////////
-
+func part2(motionMoves []string) int {
 	rope := make(rope, 10)
-	for _, input := range inputs2 {
-		direction, step := parseMotionMove(input)
+	for _, motionMove := range motionMoves {
+		direction, step := parseMotionMove(motionMove)
 		for i := 0; i < step; i++ {
 			rope.move(direction)
 		}
 	}
+	return rope.tail().visits()
+}
 
-	// 36
-	fmt.Println("Part 2:", rope.tail().visits())
+func init() {
+	aoc.Register(aoc.Puzzle[[]string, int, int]{
+		Year:  2022,
+		Day:   9,
+		Title: "Rope Bridge",
+		Parse: func(input aoc.Input) []string { return input.Lines },
+		Part1: part1,
+		Part2: part2,
+	})
 }
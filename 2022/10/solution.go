@@ -1,14 +1,14 @@
 // https://adventofcode.com/2022/day/10
 
-package main
+package day10
 
 import (
 	"fmt"
 	"math"
 	"strconv"
 	"strings"
-	"time"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 )
 
@@ -96,37 +96,31 @@ func (crt CRT) String() string {
 	return screen.String()
 }
 
-func main() {
-	fmt.Println("--- 2022 Day 10: Cathode-Ray Tube ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	////////////////////////////////////////
-
+func part1(instructions []string) int {
 	cpu := CPU{}
-	for _, instruction := range inputs {
+	for _, instruction := range instructions {
 		cpu.execute(instruction)
 	}
 
 	cycles := []int{20, 60, 100, 140, 180, 220}
+	return utils.SumFunc(cycles, cpu.strength)
+}
 
-	// 13140
-	fmt.Println("Part 1:", utils.SumFunc(cycles, cpu.strength))
-
-	////////////////////////////////////////
-
+func part2(instructions []string) string {
 	crt := CRT{w: 40, h: 6}
-	for _, instruction := range inputs {
+	for _, instruction := range instructions {
 		crt.execute(instruction)
 	}
+	return strings.TrimPrefix(crt.String(), "\n")
+}
 
-	// ##..##..##..##..##..##..##..##..##..##..
-	// ###...###...###...###...###...###...###.
-	// ####....####....####....####....####....
-	// #####.....#####.....#####.....#####.....
-	// ######......######......######......####
-	// #######.......#######.......#######.....
-	fmt.Println("Part 2:", crt)
+func init() {
+	aoc.Register(aoc.Puzzle[[]string, int, string]{
+		Year:  2022,
+		Day:   10,
+		Title: "Cathode-Ray Tube",
+		Parse: func(input aoc.Input) []string { return input.Lines },
+		Part1: part1,
+		Part2: part2,
+	})
 }
@@ -0,0 +1,220 @@
+// https://adventofcode.com/2022/day/11
+
+package day11
+
+import (
+	"cmp"
+	"strings"
+
+	"github.com/aurelbec/advent-of-code/aoc"
+	"github.com/aurelbec/advent-of-code/utils"
+)
+
+type monkeyID = int
+
+type worryLevel = int
+
+type Monkey struct {
+	ID          monkeyID     // monkey ID
+	items       []worryLevel // worry level list for each item currently holding in the order they will be inspected
+	inspections int          // number of items inspected during all rounds
+
+	testWorryLevel func(worryLevel) worryLevel // update worry level during item inspection
+
+	test            func(worryLevel) bool // use worry level to decide where to throw an item next
+	trueTestMonkey  monkeyID              // ID of the monkey which will receive item if the test is true
+	falseTestMonkey monkeyID              // ID of the monkey which will receive item if the test is false
+
+	reduceFactor worryLevel // reduction factor (product of all test divisors) to reduce worry level and avoid overflows
+}
+
+// inspect updates worry level about item during inspection, and returns to which monkey to item should be thrown
+func (monkey *Monkey) inspect(item worryLevel, worried bool) (worryLevel, monkeyID) {
+	// increase number of inspected items
+	monkey.inspections++
+
+	// update worry level after item inspection
+	item = monkey.testWorryLevel(item)
+
+	// if not worry by item inspection, reduce worry level
+	if !worried {
+		item /= 3
+	}
+
+	// trick to reduce worry level value, to avoid overflows
+	item %= monkey.reduceFactor
+
+	// determine to which monkey throw the item next
+	if monkey.test(item) {
+		return item, monkey.trueTestMonkey
+	} else {
+		return item, monkey.falseTestMonkey
+	}
+}
+
+// addItem add a new item ath the end of the current list
+func (monkey *Monkey) addItem(item worryLevel) {
+	monkey.items = append(monkey.items, item)
+}
+
+type Monkeys []*Monkey
+
+// playRound executes a complete run of monkeys inspecting and throwing items
+func (mks Monkeys) playRound(worried bool) {
+	for _, monkey := range mks {
+		for _, item := range monkey.items {
+			// let the monkey inspect item, and decide which to throw next to
+			item, next := monkey.inspect(item, worried)
+			// give the item to the selected monkey
+			mks[next].addItem(item)
+		}
+		// consider that all items has been thrown, thus empty the list
+		monkey.items = []worryLevel{}
+	}
+}
+
+// getBusiness returns the business level of the group
+func (mks Monkeys) getBusiness() int {
+	top := utils.TopK(mks, 2, func(a, b *Monkey) int { return cmp.Compare(a.inspections, b.inspections) })
+	return top[0].inspections * top[1].inspections
+}
+
+// parseOperand returns the value of a constant operand of an operation, 0 for the "old" value
+func parseOperand(operand string) (worryLevel, error) {
+	if operand == "old" {
+		return 0, nil
+	}
+	return utils.ParseInt(operand)
+}
+
+// getMonkeys returns a list of monkeys created using description as inputs
+func getMonkeys(inputs []string) Monkeys {
+	reduceFactor := worryLevel(1)
+
+	// every section describes a monkey
+	monkeys := utils.Must(utils.MapSections(inputs, func(section utils.Section) (*Monkey, error) {
+		monkey := &Monkey{}
+		for i, input := range section.Lines {
+			input = strings.TrimSpace(input)
+			switch {
+			case strings.HasPrefix(input, "Monkey"):
+				// set the monkey ID
+				if err := utils.Scan(input, "Monkey {id}:", &monkey.ID); err != nil {
+					return nil, section.Errorf(i, "invalid monkey: %w", err)
+				}
+
+			case strings.HasPrefix(input, "Starting items:"):
+				// set the list of monkey starting items
+				if err := utils.Scan(input, "Starting items: {items:list}", &monkey.items); err != nil {
+					return nil, section.Errorf(i, "invalid items: %w", err)
+				}
+
+			case strings.HasPrefix(input, "If true: throw to monkey"):
+				// set the monkey target for a true test
+				if err := utils.Scan(input, "If true: throw to monkey {monkey}", &monkey.trueTestMonkey); err != nil {
+					return nil, section.Errorf(i, "invalid target: %w", err)
+				}
+
+			case strings.HasPrefix(input, "If false: throw to monkey"):
+				// set the monkey target for a false test
+				if err := utils.Scan(input, "If false: throw to monkey {monkey}", &monkey.falseTestMonkey); err != nil {
+					return nil, section.Errorf(i, "invalid target: %w", err)
+				}
+
+			case strings.HasPrefix(input, "Test: divisible by"):
+				var divisor worryLevel
+				if err := utils.Scan(input, "Test: divisible by {divisor}", &divisor); err != nil {
+					return nil, section.Errorf(i, "invalid test: %w", err)
+				}
+
+				// set the test func to check if a worry level is divisible by the input
+				monkey.test = func(item worryLevel) bool {
+					return item%divisor == 0
+				}
+
+				// multiply every divisors by each other to get a common factor used to reduce worry level later
+				reduceFactor *= divisor
+
+			case strings.HasPrefix(input, "Operation:"):
+				// set the operation func updating worry level during inspection
+				var left, operator, right string
+				if err := utils.Scan(input, "Operation: new = {left} {operator:enum(+|-|*|/)} {right}", &left, &operator, &right); err != nil {
+					return nil, section.Errorf(i, "invalid operation: %w", err)
+				}
+
+				// get operation operands, an "old" operand being replaced by the current value
+				lhs, err := parseOperand(left)
+				if err != nil {
+					return nil, section.Errorf(i, "invalid left operand: %w", err)
+				}
+				rhs, err := parseOperand(right)
+				if err != nil {
+					return nil, section.Errorf(i, "invalid right operand: %w", err)
+				}
+
+				monkey.testWorryLevel = func(old worryLevel) worryLevel {
+					// if left-hand operand is "old", use current value
+					if left == "old" {
+						lhs = old
+					}
+					// if right-hand operand is "old", use current value
+					if right == "old" {
+						rhs = old
+					}
+
+					// return operation result
+					switch operator {
+					case "+":
+						return lhs + rhs
+					case "-":
+						return lhs - rhs
+					case "*":
+						return lhs * rhs
+					case "/":
+						return lhs / rhs
+					default:
+						return -1
+					}
+				}
+
+			default:
+				return nil, section.Errorf(i, "unexpected monkey description %q", input)
+			}
+		}
+		return monkey, nil
+	}))
+
+	// set the reduce factor for all monkeys
+	for _, monkey := range monkeys {
+		monkey.reduceFactor = reduceFactor
+	}
+
+	return monkeys
+}
+
+func part1(monkeys Monkeys) int {
+	// play 20 rounds, without being worried about items inspection
+	for i := 0; i < 20; i++ {
+		monkeys.playRound(false)
+	}
+	return monkeys.getBusiness()
+}
+
+func part2(monkeys Monkeys) int {
+	// play 10000 rounds, being worried about items inspection
+	for i := 0; i < 10_000; i++ {
+		monkeys.playRound(true)
+	}
+	return monkeys.getBusiness()
+}
+
+func init() {
+	aoc.Register(aoc.Puzzle[Monkeys, int, int]{
+		Year:  2022,
+		Day:   11,
+		Title: "Monkey in the Middle",
+		Parse: aoc.Lines(getMonkeys),
+		Part1: part1,
+		Part2: part2,
+	})
+}
@@ -1,14 +1,16 @@
 // https://adventofcode.com/2022/day/12
 
-package main
+package day12
 
 import (
-	"fmt"
-	"time"
-
-	"github.com/aurelbec/advent-of-code/utils"
+	"github.com/aurelbec/advent-of-code/aoc"
+	"github.com/aurelbec/advent-of-code/utils/collections"
 )
 
+type World struct {
+	start, end *Node
+}
+
 type Node struct {
 	elevation        rune
 	neighbors        []*Node
@@ -33,11 +35,11 @@ func (from *Node) connect(to *Node, reverse bool) {
 
 // getShortestPathLength returns the length of the shorter path from start and the first goal reached
 func getShortestPathLength(start *Node, neighbors func(*Node) []*Node, goalReached func(*Node) bool) int {
-	openList := []*Node{start}
+	openList := collections.NewQueue(start)
 	costs := map[*Node]int{start: 0}
 
-	for len(openList) != 0 {
-		start, openList = openList[0], openList[1:] // pop the first node, it will have the lower cost
+	for !openList.IsEmpty() {
+		start, _ = openList.Dequeue() // pop the first node, it will have the lower cost
 		currentCost := costs[start]
 
 		if goalReached(start) {
@@ -47,7 +49,7 @@ func getShortestPathLength(start *Node, neighbors func(*Node) []*Node, goalReach
 		for _, neighbor := range neighbors(start) {
 			if _, visited := costs[neighbor]; !visited {
 				costs[neighbor] = currentCost + 1
-				openList = append(openList, neighbor) // node are visited with BFS strategy, append them to the list
+				openList.Enqueue(neighbor) // node are visited with BFS strategy, append them to the list
 			}
 		}
 	}
@@ -55,8 +57,8 @@ func getShortestPathLength(start *Node, neighbors func(*Node) []*Node, goalReach
 	return -1
 }
 
-// parseWorld parses inputs to create a graph of Node, and returns the start and end
-func parseWorld(inputs []string) (start *Node, end *Node) {
+// parseWorld parses inputs to create a graph of Node, and returns the world with its start and end
+func parseWorld(inputs []string) (world World) {
 	var previousRow []*Node = make([]*Node, len(inputs[0])) // keep trace of the previous upper nodes
 	for _, row := range inputs {
 		var previousNode *Node = nil // keep trace of the previous left node
@@ -65,10 +67,10 @@ func parseWorld(inputs []string) (start *Node, end *Node) {
 
 			if elevation == 'S' { // found start
 				node.elevation = 'a'
-				start = node
+				world.start = node
 			} else if elevation == 'E' { // found end
 				node.elevation = 'z'
-				end = node
+				world.end = node
 			} else {
 				node.elevation = elevation
 			}
@@ -84,36 +86,35 @@ func parseWorld(inputs []string) (start *Node, end *Node) {
 	return
 }
 
-func main() {
-	fmt.Println("--- 2022 Day 12: Hill Climbing Algorithm ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	start, end := parseWorld(inputs)
-
-	////////////////////////////////////////
-
-	// 31
-	fmt.Println("Part 1:", getShortestPathLength(
+func part1(world World) int {
+	return getShortestPathLength(
 		// begin from start node
-		start,
+		world.start,
 		// navigate through normal neighbors
 		func(node *Node) []*Node { return node.neighbors },
 		// stop at end
-		func(node *Node) bool { return node == end },
-	))
-
-	////////////////////////////////////////
+		func(node *Node) bool { return node == world.end },
+	)
+}
 
-	// 29
-	fmt.Println("Part 2:", getShortestPathLength(
+func part2(world World) int {
+	return getShortestPathLength(
 		// begin from end node
-		end,
+		world.end,
 		// navigate through reversed neighbors, as we start high to the target the lowest level
 		func(node *Node) []*Node { return node.neighborsReverse },
 		// stop at elevation level 'a'
 		func(node *Node) bool { return node.elevation == 'a' },
-	))
+	)
+}
+
+func init() {
+	aoc.Register(aoc.Puzzle[World, int, int]{
+		Year:  2022,
+		Day:   12,
+		Title: "Hill Climbing Algorithm",
+		Parse: aoc.Lines(parseWorld),
+		Part1: part1,
+		Part2: part2,
+	})
 }
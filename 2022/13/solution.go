@@ -1,14 +1,13 @@
 // https://adventofcode.com/2022/day/13
 
-package main
+package day13
 
 import (
-	"encoding/json"
 	"fmt"
 	"slices"
 	"strings"
-	"time"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 )
 
@@ -21,7 +20,6 @@ const (
 type Data interface {
 	fmt.Stringer
 
-	unmarshal(interface{}) Data
 	compare(Data) int
 }
 
@@ -37,12 +35,6 @@ func (integer *Integer) toPacket() *Packet {
 	return &Packet{integer}
 }
 
-// unmarshal unmarshals an interface (assuming float64) into an Integer data
-func (integer *Integer) unmarshal(data interface{}) Data {
-	*integer = Integer(data.(float64))
-	return integer
-}
-
 // compare compares this Integer data with an other, and returns whether there are equals, greater or smaller
 func (left Integer) compare(right Data) int {
 	switch right := right.(type) {
@@ -77,29 +69,17 @@ func (packet Packet) String() (s string) {
 	return fmt.Sprintf("[%s]", strings.Join(values, ", "))
 }
 
-// UnmarshalJSON parses the JSON-encoded raw data and stores the result in the Packet data
-func (packet *Packet) UnmarshalJSON(raw []byte) error {
-	var data []interface{}
-	json.Unmarshal(raw, &data)
-	packet.unmarshal(data)
-	return nil
-}
-
-// unmarshal unmarshals an interface (assuming []any) into a Packet data
-// a Packet is compose of multiple data, so every data contained is marshalled and added into it
-func (packet *Packet) unmarshal(data interface{}) Data {
-	for _, data := range data.([]interface{}) {
-		switch data.(type) {
-		// marshal into a new Integer data
-		case float64:
-			*packet = append(*packet, new(Integer).unmarshal(data))
-		// marshal into a new Packet data
-		case []interface{}:
-			*packet = append(*packet, new(Packet).unmarshal(data))
-		}
-	}
-	return packet
-}
+// packetParser parses a Packet, a list of Integer and Packet data such as [1,[2,[]]]
+var packetParser = utils.Recursive(func(packet utils.Parser[*Packet]) utils.Parser[*Packet] {
+	data := utils.Or(
+		utils.Map(utils.Int(), func(value int) Data { integer := Integer(value); return &integer }),
+		utils.Map(packet, func(packet *Packet) Data { return packet }),
+	)
+	return utils.Map(utils.Between("[", utils.SeparatedBy(data, ","), "]"), func(values []Data) *Packet {
+		packet := Packet(values)
+		return &packet
+	})
+})
 
 // compare compares this Packet data with an other, and returns whether there are equals, greater or smaller
 func (left Packet) compare(right Data) int {
@@ -181,37 +161,37 @@ func (pairs Pairs) rightOrderedPairs() (rightOrdered int) {
 }
 
 // parsePairs parses input and returns a list of Packet pair
-func parsePairs(inputs []string) (pairs Pairs) {
-	for i := 0; i < len(inputs)-1; i += 2 {
-		var pair [2]Packet
-		json.Unmarshal([]byte(inputs[i]), &pair[0])
-		json.Unmarshal([]byte(inputs[i+1]), &pair[1])
-		pairs = append(pairs, pair)
-
-		// skip empty lines
-		if i < len(inputs)-2 && inputs[i+2] == "" {
-			i++
+func parsePairs(inputs []string) Pairs {
+	return utils.Must(utils.MapSections(inputs, func(section utils.Section) (pair [2]Packet, err error) {
+		if len(section.Lines) != len(pair) {
+			return pair, fmt.Errorf("%d packets found, expected a pair", len(section.Lines))
 		}
-	}
-	return
+		for i, line := range section.Lines {
+			packet, err := packetParser.Parse(line)
+			if err != nil {
+				return pair, section.Errorf(i, "invalid packet: %w", err)
+			}
+			pair[i] = *packet
+		}
+		return pair, nil
+	}))
 }
 
-func main() {
-	fmt.Println("--- 2022 Day 13: Distress Signal ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	pairs := parsePairs(inputs)
-
-	////////////////////////////////////////
-
-	// 13
-	fmt.Println("Part 1:", pairs.rightOrderedPairs())
+func part1(pairs Pairs) int {
+	return pairs.rightOrderedPairs()
+}
 
-	////////////////////////////////////////
+func part2(pairs Pairs) int {
+	return Packet(pairs.flat()).getDecoderKey(2, 6)
+}
 
-	// 140
-	fmt.Println("Part 2:", Packet(pairs.flat()).getDecoderKey(2, 6))
+func init() {
+	aoc.Register(aoc.Puzzle[Pairs, int, int]{
+		Year:  2022,
+		Day:   13,
+		Title: "Distress Signal",
+		Parse: aoc.Lines(parsePairs),
+		Part1: part1,
+		Part2: part2,
+	})
 }
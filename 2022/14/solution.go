@@ -0,0 +1,207 @@
+// https://adventofcode.com/2022/day/14
+
+package day14
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aurelbec/advent-of-code/aoc"
+	"github.com/aurelbec/advent-of-code/utils"
+)
+
+const (
+	void   = '§'
+	air    = ' '
+	trace  = '.'
+	rock   = '#'
+	sand   = 'o'
+	ground = '='
+)
+
+type Cave struct {
+	layout       utils.Grid[byte]
+	left         int // X of the leftmost column of the layout
+	bottom       int // Y of the lowest rock
+	floorEnabled bool
+}
+
+// String returns a graphical representation of the cave's layout
+func (cave Cave) String() string {
+	return cave.layout.String()
+}
+
+// clear resets all blocks that are not obstacles to air
+func (cave *Cave) clear() {
+	cave.layout.Each(func(location utils.Location2D[int], block byte) {
+		if block != ground && block != rock {
+			cave.layout.Set(location, air)
+		}
+	})
+}
+
+// enableFloor makes the bottom layer of solid ground reachable, instead of falling into the void
+func (cave *Cave) enableFloor() {
+	cave.floorEnabled = true
+}
+
+// toLayout returns the location in the layout of the cave location x,y
+func (cave *Cave) toLayout(location utils.Location2D[int]) utils.Location2D[int] {
+	return location.MovedBy(-cave.left, 0)
+}
+
+// set sets the block at location x,y to the given type
+func (cave *Cave) set(location utils.Location2D[int], value byte) {
+	cave.layout.Set(cave.toLayout(location), value)
+}
+
+// get returns the block type at location x,y
+// below the lowest rock, it's a void unless the floor is enabled
+func (cave *Cave) get(location utils.Location2D[int]) byte {
+	if !cave.floorEnabled && location.Y > cave.bottom {
+		return void
+	}
+	return cave.layout.At(cave.toLayout(location))
+}
+
+// putSand puts a sand unit at the given x,y location
+// the sand unit will fall to a rest location
+// it returns true if a rest location is found, false if the unit could not be put or fall into the void
+func (cave *Cave) putSand(start utils.Location2D[int]) bool {
+	// if the start location is not free, return failure
+	if block := cave.get(start); block != air && block != trace {
+		return false
+	}
+
+	// add a sand trace
+	cave.set(start, trace)
+
+	for _, next := range [...]utils.Location2D[int]{
+		{X: start.X, Y: start.Y + 1},     // always falls down one step if possible
+		{X: start.X - 1, Y: start.Y + 1}, // else, move one step down and to the left
+		{X: start.X + 1, Y: start.Y + 1}, // else, move one step down and to the right
+	} {
+		switch cave.get(next) {
+		// if next location is free, continue
+		case air, trace:
+			return cave.putSand(next)
+		// if next location is void, return failure
+		case void:
+			return false
+		}
+	}
+
+	// if next location is free, put sand unit here
+	cave.set(start, sand)
+	return true
+}
+
+// caveFromLines creates a cave from the a layout described by lines
+// the layout is wide enough for the sand poured from the hole to rest on the floor, two levels below the lowest rock
+func caveFromLines(lines [][]utils.Location2D[int]) (cave Cave) {
+	// get cave dimensions first
+	left, right := sandHole.X, sandHole.X
+	for _, line := range lines {
+		for _, c := range line {
+			left, right = min(left, c.X), max(right, c.X)
+			cave.bottom = max(cave.bottom, c.Y)
+		}
+	}
+	floor := cave.bottom + 2
+	cave.left = min(left, sandHole.X-floor)
+	right = max(right, sandHole.X+floor)
+
+	// set blank layout, with the floor
+	cave.layout = utils.NewGrid[byte](right-cave.left+1, floor+1)
+	for y := 0; y <= floor; y++ {
+		block := byte(air)
+		if y == floor {
+			block = ground
+		}
+		row := cave.layout.Row(y)
+		for x := range row {
+			row[x] = block
+		}
+	}
+
+	// add rocks
+	for _, line := range lines {
+		from := line[0]
+		for i := 1; i < len(line); i++ {
+			to := line[i]
+			dy, dx := 1, 1
+			if from.Y > to.Y {
+				dy = -1
+			}
+			if from.X > to.X {
+				dx = -1
+			}
+			rockLocation := from
+			for ; (rockLocation.Y >= to.Y && dy < 0) || (rockLocation.Y <= to.Y && dy > 0); rockLocation.Y += dy {
+				for rockLocation.X = from.X; (rockLocation.X >= to.X && dx < 0) || (rockLocation.X <= to.X && dx > 0); rockLocation.X += dx {
+					cave.set(rockLocation, rock)
+				}
+			}
+			from = to
+		}
+	}
+
+	return
+}
+
+// parseLine returns the points of a rock line
+func parseLine(input string) ([]utils.Location2D[int], error) {
+	coordinates := strings.Split(input, " -> ")
+	line := make([]utils.Location2D[int], len(coordinates))
+	for c, coordinate := range coordinates {
+		if err := utils.Scan(coordinate, "{x},{y}", &line[c].X, &line[c].Y); err != nil {
+			return line, fmt.Errorf("point %d %q: %v", c+1, coordinate, err)
+		}
+	}
+	return line, nil
+}
+
+// parseLines parses input and returns lines layout
+func parseLines(inputs []string) [][]utils.Location2D[int] {
+	return utils.Must(utils.MapLines(inputs, parseLine))
+}
+
+var sandHole = utils.NewLocation2D(500, 0)
+
+// parseCave returns the cave built from the rock lines
+func parseCave(inputs []string) Cave {
+	return caveFromLines(parseLines(inputs))
+}
+
+func part1(cave Cave) int {
+	units := 0
+	for ; cave.putSand(sandHole); units++ {
+		// fmt.Print("\033[H\033[2J")
+		// fmt.Println(cave)
+		// time.Sleep(50 * time.Millisecond)
+	}
+	return units
+}
+
+func part2(cave Cave) int {
+	cave.enableFloor()
+
+	units := 0
+	for ; cave.putSand(sandHole); units++ {
+		// fmt.Print("\033[H\033[2J")
+		// fmt.Println(cave)
+		// time.Sleep(10 * time.Millisecond)
+	}
+	return units
+}
+
+func init() {
+	aoc.Register(aoc.Puzzle[Cave, int, int]{
+		Year:  2022,
+		Day:   14,
+		Title: "Regolith Reservoir",
+		Parse: aoc.Lines(parseCave),
+		Part1: part1,
+		Part2: part2,
+	})
+}
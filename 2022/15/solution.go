@@ -1,11 +1,9 @@
 // https://adventofcode.com/2022/day/15
 
-package main
+package day15
 
 import (
-	"fmt"
-	"time"
-
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 )
 
@@ -21,6 +19,9 @@ type Beacon struct {
 type System struct {
 	sensors []Sensor
 	beacons []Beacon
+
+	row        int                 // row to look at for impossible locations
+	searchArea utils.Interval[int] // area in which the distress beacon is
 }
 
 // tuningFrequency returns the tuning frequency of a beacon at the given location
@@ -76,6 +77,31 @@ func (system System) getPossibleBeaconLocation(searchArea utils.Interval[int]) u
 	return utils.NewLocation2D(-1, -1)
 }
 
+// parse parses the system, and sets the area to look at which is smaller for the example
+func parse(input aoc.Input) System {
+	system := parseSystem(input.Lines)
+	if input.IsExample() {
+		system.row, system.searchArea = 10, utils.NewInterval(0, 20)
+	} else {
+		system.row, system.searchArea = 2000000, utils.NewInterval(0, 4000000)
+	}
+	return system
+}
+
+// Report is the location of a sensor and of its closest beacon
+type Report struct {
+	sensor Sensor
+	beacon Beacon
+}
+
+// parseReport returns the locations of the sensor and of the beacon reported by the input
+func parseReport(input string) (Report, error) {
+	report := Report{}
+	err := utils.Scan(input, "Sensor at x={sensorX}, y={sensorY}: closest beacon is at x={beaconX}, y={beaconY}",
+		&report.sensor.X, &report.sensor.Y, &report.beacon.X, &report.beacon.Y)
+	return report, err
+}
+
 // parseSystem parses input and returns the list of sensors and beacons in the system
 func parseSystem(inputs []string) System {
 	system := System{
@@ -84,10 +110,9 @@ func parseSystem(inputs []string) System {
 	}
 
 	beaconsMap := make(map[Beacon]bool, len(inputs))
-	for _, input := range inputs {
-		sensor := Sensor{}
-		beacon := Beacon{}
-		fmt.Sscanf(input, "Sensor at x=%v, y=%v: closest beacon is at x=%v, y=%v", &sensor.X, &sensor.Y, &beacon.X, &beacon.Y)
+	reports := utils.Must(utils.MapLines(inputs, parseReport))
+	for _, report := range reports {
+		sensor, beacon := report.sensor, report.beacon
 
 		// ensure beacon existence and unity
 		if !beaconsMap[beacon] {
@@ -104,22 +129,21 @@ func parseSystem(inputs []string) System {
 	return system
 }
 
-func main() {
-	fmt.Println("--- 2022 Day 15: Beacon Exclusion Zone ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	system := parseSystem(inputs)
-
-	////////////////////////////////////////
-
-	// 26
-	fmt.Println("Part 1:", system.impossibleBeaconLocationsOnRow(10))
+func part1(system System) int {
+	return system.impossibleBeaconLocationsOnRow(system.row)
+}
 
-	////////////////////////////////////////
+func part2(system System) int {
+	return tuningFrequency(system.getPossibleBeaconLocation(system.searchArea), 4000000)
+}
 
-	// 56000011
-	fmt.Println("Part 2:", tuningFrequency(system.getPossibleBeaconLocation(utils.NewInterval(0, 4000000)), 4000000))
+func init() {
+	aoc.Register(aoc.Puzzle[System, int, int]{
+		Year:  2022,
+		Day:   15,
+		Title: "Beacon Exclusion Zone",
+		Parse: parse,
+		Part1: part1,
+		Part2: part2,
+	})
 }
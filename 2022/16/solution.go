@@ -1,18 +1,17 @@
 // https://adventofcode.com/2022/day/16
 
-package main
+package day16
 
 import (
 	"fmt"
-	"strings"
-	"time"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 )
 
 type Valve struct {
 	id   string
-	mask int
+	mask utils.FixedBitset
 
 	flow          int
 	shortestPaths map[*Valve]int
@@ -20,20 +19,20 @@ type Valve struct {
 
 type Path struct {
 	pressure int
-	mask     int
+	mask     utils.FixedBitset
 }
 
 // getPossiblePaths returns the list of all feasible paths in the given time
 func getPossiblePaths(time int, start *Valve) []Path {
-	return getPathsRec(0, time, 0, 0, start)
+	return getPathsRec(0, time, utils.FixedBitset{}, utils.FixedBitset{}, start)
 }
 
 // getPathsRec returns the list of all feasible paths in the given time recursively
 // it uses a cache of opened valves and current path valve ids
-func getPathsRec(pressure int, remaining int, opened int, path int, node *Valve) []Path {
+func getPathsRec(pressure int, remaining int, opened, path utils.FixedBitset, node *Valve) []Path {
 	paths := []Path{{pressure: pressure, mask: path}}
 	for next, cost := range node.shortestPaths {
-		if opened&next.mask != 0 || next.flow == 0 { // opened, skip
+		if !opened.And(next.mask).IsEmpty() || next.flow == 0 { // opened, skip
 			continue
 		}
 
@@ -43,23 +42,30 @@ func getPathsRec(pressure int, remaining int, opened int, path int, node *Valve)
 			continue
 		}
 
-		paths = append(paths, getPathsRec(pressure+(remaining*next.flow), remaining, opened|next.mask, path|next.mask, next)...)
+		paths = append(paths, getPathsRec(pressure+(remaining*next.flow), remaining, opened.Or(next.mask), path.Or(next.mask), next)...)
 	}
 	return paths
 }
 
+var valveScanner = utils.MustCompileScanner("Valve {id} has flow rate={flow}; [tunnels lead|tunnel leads] to [valves|valve] {next:list}")
+
 // parseValves parses input and return the map of valves
 func parseValves(inputs []string) (valves map[string]*Valve) {
+	if len(inputs) > utils.FixedBitsetSize {
+		panic(fmt.Errorf("%d valves found, at most %d are supported", len(inputs), utils.FixedBitsetSize))
+	}
 	valves = make(map[string]*Valve, len(inputs))
 
 	neighborsID := make(map[*Valve][]string)
 	for i, input := range inputs {
-		valve := &Valve{mask: 1 << i}
+		valve := &Valve{mask: utils.NewFixedBitset(i)}
 
-		parts := strings.SplitAfter(input, "; ")
-		fmt.Sscanf(parts[0], "Valve %s has flow rate=%d", &valve.id, &valve.flow)
+		var next []string
+		if err := valveScanner.Scan(input, &valve.id, &valve.flow, &next); err != nil {
+			panic(fmt.Errorf("line %d: %w", i+1, err))
+		}
 
-		neighborsID[valve] = strings.Split(strings.Trim(parts[1], "tunelsadov "), ", ")
+		neighborsID[valve] = next
 		valves[valve.id] = valve
 	}
 
@@ -88,36 +94,33 @@ func parseValves(inputs []string) (valves map[string]*Valve) {
 	return
 }
 
-func main() {
-	fmt.Println("--- 2022 Day 16: Proboscidea Volcanium ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	valves := parseValves(inputs)
-
-	////////////////////////////////////////
-
+func part1(valves map[string]*Valve) int {
 	pressureMax, paths := 0, getPossiblePaths(30, valves["AA"])
 	for _, path := range paths {
 		pressureMax = utils.Max(pressureMax, path.pressure)
 	}
+	return pressureMax
+}
 
-	// 1651
-	fmt.Println("Part 1:", pressureMax)
-
-	////////////////////////////////////////
-
-	pressureMax, paths = 0, getPossiblePaths(26, valves["AA"])
+func part2(valves map[string]*Valve) int {
+	pressureMax, paths := 0, getPossiblePaths(26, valves["AA"])
 	for i, me := range paths {
 		for _, elephant := range paths[i:] {
-			if me.mask&elephant.mask == 0 { // ensure no common part
+			if me.mask.And(elephant.mask).IsEmpty() { // ensure no common part
 				pressureMax = utils.Max(pressureMax, me.pressure+elephant.pressure)
 			}
 		}
 	}
+	return pressureMax
+}
 
-	// 1707
-	fmt.Println("Part 2:", pressureMax)
+func init() {
+	aoc.Register(aoc.Puzzle[map[string]*Valve, int, int]{
+		Year:  2022,
+		Day:   16,
+		Title: "Proboscidea Volcanium",
+		Parse: aoc.Lines(parseValves),
+		Part1: part1,
+		Part2: part2,
+	})
 }
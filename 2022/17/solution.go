@@ -1,11 +1,11 @@
 // https://adventofcode.com/2022/day/17
 
-package main
+package day17
 
 import (
 	"fmt"
-	"time"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 )
 
@@ -41,14 +41,20 @@ type Rock struct {
 	pos     utils.Location2D[int]
 }
 
+// State describes the top row of the cave, along with the next rock and jet
+type State struct {
+	top       utils.FixedBitset // blocks of the top row, by x
+	rock, jet int               // indexes of the next rock and jet
+}
+
 type Cave struct {
 	width  int      // cave width
 	layout [][]byte // current cave layout
 	offset int      // cave height offset
 
-	heights []int       // cave height after each rock fall
-	rows    []int       // cave top row state after each rock fall
-	states  map[int]int // cache of all top rows state + rock + jet after each rock fall and index it was encountered
+	heights []int               // cave height after each rock fall
+	rows    []utils.FixedBitset // cave top row state after each rock fall
+	states  map[State]int       // cache of all top rows state + rock + jet after each rock fall and index it was encountered
 
 	currentRock Rock // current rock falling
 
@@ -150,25 +156,19 @@ func (cave *Cave) stopCurrentRock() {
 	}
 }
 
-// topLineMask returns a binary representation of the top line
-func (cave Cave) topLineMask() (mask int) {
-	if len(cave.layout) == 0 {
-		return (1 << cave.width) - 1
-	}
-
-	for i, r := range cave.layout[0] {
-		if r == '#' {
-			mask |= 1 << i
+// topLineMask returns the blocks of the top line, the floor being full
+func (cave Cave) topLineMask() (mask utils.FixedBitset) {
+	for i := 0; i < cave.width; i++ {
+		if len(cave.layout) == 0 || cave.layout[0][i] == '#' {
+			mask.Set(i)
 		}
 	}
-	return
+	return mask
 }
 
-// topLineState returns a unique value describing the top row and the next piece and jet
-func (cave Cave) topLineState() int {
-	r := len(cave.rocks.Values())
-	j := len(cave.jets.Values())
-	return cave.topLineMask()*r*j + cave.rocks.Index()*j + cave.jets.Index()
+// topLineState returns the state describing the top row and the next piece and jet
+func (cave Cave) topLineState() State {
+	return State{top: cave.topLineMask(), rock: cave.rocks.Index(), jet: cave.jets.Index()}
 }
 
 // simulateFall simulates the fall of n rocks, and return the height of the cave after that
@@ -212,7 +212,7 @@ func (cave *Cave) simulateFall(n int) int {
 		cave.stopCurrentRock()
 
 		// remove free rows
-		for cave.topLineMask() == 0 {
+		for cave.topLineMask().IsEmpty() {
 			cave.layout = cave.layout[1:]
 		}
 
@@ -268,30 +268,32 @@ func (cave *Cave) simulateFall(n int) int {
 
 // getNewCave initialises a new cave from lists of rocks and jets
 func getNewCave(width int, rocks [][][]byte, input string) *Cave {
+	if width > utils.FixedBitsetSize {
+		panic(fmt.Sprintf("cave width %d, at most %d is supported", width, utils.FixedBitsetSize))
+	}
 	return &Cave{
 		width:  width,
 		rocks:  utils.NewCyclicArray(utils.ArrayMap(rocks, func(rock [][]byte) Rock { return Rock{layout: rock} })...),
 		jets:   utils.NewCyclicArray([]byte(input)...),
-		states: make(map[int]int),
+		states: make(map[State]int),
 	}
 }
 
-func main() {
-	fmt.Println("--- 2022 Day 17: Pyroclastic Flow ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	input := inputs[0]
-
-	////////////////////////////////////////
-
-	// 3068
-	fmt.Println("Part 1:", getNewCave(7, rocks[:], input).simulateFall(2022))
+func part1(jets string) int {
+	return getNewCave(7, rocks[:], jets).simulateFall(2022)
+}
 
-	////////////////////////////////////////
+func part2(jets string) int {
+	return getNewCave(7, rocks[:], jets).simulateFall(1_000_000_000_000)
+}
 
-	// 1514285714288
-	fmt.Println("Part 2:", getNewCave(7, rocks[:], input).simulateFall(1_000_000_000_000))
+func init() {
+	aoc.Register(aoc.Puzzle[string, int, int]{
+		Year:  2022,
+		Day:   17,
+		Title: "Pyroclastic Flow",
+		Parse: func(input aoc.Input) string { return input.Lines[0] },
+		Part1: part1,
+		Part2: part2,
+	})
 }
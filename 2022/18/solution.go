@@ -1,13 +1,14 @@
 // https://adventofcode.com/2022/day/18
 
-package main
+package day18
 
 import (
 	"fmt"
 	"math"
-	"time"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
+	"github.com/aurelbec/advent-of-code/utils/collections"
 )
 
 const (
@@ -62,32 +63,32 @@ func (boulder Boulder) getSurfaceArea() (surfaceArea int) {
 
 // getVisibleSurfaceArea returns the area touching air only on the outside
 func (boulder Boulder) getVisibleSurfaceArea() (surfaceArea int) {
-	visited := make(map[utils.Location3D[int]]bool)
-
-	// start a flood-fill algorithm at location (0,0,0), clear by design
-	flood := utils.NewUnorderedArray(utils.Location3D[int]{X: 0, Y: 0, Z: 0})
-	for len(flood) > 0 {
-		water := flood.Remove(0)
-
-		// do not visit twice
-		if visited[water] {
-			continue
-		}
-		visited[water] = true
-
-		// for each neighbor
-		for _, neighbor := range boulder.cubeNeighbors(water) {
-			// ensure in range
-			if boulder.outOfRange(neighbor) {
-				continue
+	// connect adjacent air cubes, the outside air being the component of (0,0,0), clear by design
+	air := collections.NewDisjointSet[utils.Location3D[int]]()
+	for z := boulder.ranges[Z].Min; z <= boulder.ranges[Z].Max; z++ {
+		for y := boulder.ranges[Y].Min; y <= boulder.ranges[Y].Max; y++ {
+			for x := boulder.ranges[X].Min; x <= boulder.ranges[X].Max; x++ {
+				cube := utils.Location3D[int]{X: x, Y: y, Z: z}
+				if boulder.isSolid(cube) {
+					continue
+				}
+				air.Add(cube)
+				// neighbors at odd indexes are the next ones along each axis
+				for i, neighbor := range boulder.cubeNeighbors(cube) {
+					if i%2 == 1 && !boulder.outOfRange(neighbor) && !boulder.isSolid(neighbor) {
+						air.Union(cube, neighbor)
+					}
+				}
 			}
+		}
+	}
+	outside := air.Find(utils.Location3D[int]{X: 0, Y: 0, Z: 0})
 
-			// if it's a solid block, then this current face is on the external surface
-			if boulder.isSolid(neighbor) {
+	// a face is on the external surface if it touches the outside air
+	for _, cube := range boulder.cubes {
+		for _, neighbor := range boulder.cubeNeighbors(cube) {
+			if boulder.outOfRange(neighbor) || (!boulder.isSolid(neighbor) && air.Find(neighbor) == outside) {
 				surfaceArea++
-			} else {
-				// else continue the flood by exploring the neighbor
-				flood.Insert(neighbor)
 			}
 		}
 	}
@@ -140,22 +141,21 @@ func getBoulder(input []string) *Boulder {
 	return &boulder
 }
 
-func main() {
-	fmt.Println("--- 2022 Day 18: Boiling Boulders ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	boulder := getBoulder(inputs)
-
-	////////////////////////////////////////
-
-	// 64
-	fmt.Println("Part 1:", boulder.getSurfaceArea())
+func part1(boulder *Boulder) int {
+	return boulder.getSurfaceArea()
+}
 
-	////////////////////////////////////////
+func part2(boulder *Boulder) int {
+	return boulder.getVisibleSurfaceArea()
+}
 
-	// 58
-	fmt.Println("Part 2:", boulder.getVisibleSurfaceArea())
+func init() {
+	aoc.Register(aoc.Puzzle[*Boulder, int, int]{
+		Year:  2022,
+		Day:   18,
+		Title: "Boiling Boulders",
+		Parse: aoc.Lines(getBoulder),
+		Part1: part1,
+		Part2: part2,
+	})
 }
@@ -1,12 +1,11 @@
 // https://adventofcode.com/2022/day/19
 
-package main
+package day19
 
 import (
-	"fmt"
 	"math"
-	"time"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 )
 
@@ -24,10 +23,6 @@ type State struct {
 	resources [N]int
 }
 
-func (s State) hash() string {
-	return fmt.Sprint(s.time, s.resources[ore], s.resources[clay], s.resources[obsidian], s.resources[geode], s.robots[ore], s.robots[clay], s.robots[obsidian], s.robots[geode])
-}
-
 func (state *State) forward(time int) {
 	state.time += time
 	for resource, number := range state.robots {
@@ -48,7 +43,7 @@ type Blueprint struct {
 
 func (blueprint Blueprint) getMax(resource int, timeLimit int) int {
 	max := 0
-	cache := make(map[string]struct{})
+	visited := utils.NewSet[State]()
 
 	var explore func(State)
 	explore = func(state State) {
@@ -58,12 +53,12 @@ func (blueprint Blueprint) getMax(resource int, timeLimit int) int {
 		}
 
 		for _, next := range blueprint.nextStates(state, timeLimit) {
-			hash := next.hash()
-			if _, visited := cache[hash]; visited {
+			if visited.Has(next) {
 				continue
 			}
-			cache[hash] = struct{}{}
+			visited.Add(next)
 
+			// a state that can not beat the best found so far is not worth exploring
 			if max > 0 && next.getMaxUntilEnd(resource, timeLimit) <= max {
 				continue
 			}
@@ -149,48 +144,52 @@ func (blueprints Blueprints) getMaxMultiplied(n int, timeLimit int) int {
 	return mul
 }
 
-func parseBlueprints(inputs []string) Blueprints {
-	blueprints := make([]Blueprint, len(inputs))
-
-	for i, input := range inputs {
-		fmt.Sscanf(
-			input,
-			"Blueprint %v: Each ore robot costs %v ore. Each clay robot costs %v ore. Each obsidian robot costs %v ore and %v clay. Each geode robot costs %v ore and %v obsidian.",
-			&blueprints[i].ID,
-			&blueprints[i].robotCosts[ore][ore],
-			&blueprints[i].robotCosts[clay][ore],
-			&blueprints[i].robotCosts[obsidian][ore],
-			&blueprints[i].robotCosts[obsidian][clay],
-			&blueprints[i].robotCosts[geode][ore],
-			&blueprints[i].robotCosts[geode][obsidian],
-		)
-
-		for cost := 0; cost < N; cost++ {
-			for resource := 0; resource < N; resource++ {
-				blueprints[i].maxRobots[cost] = utils.Max(blueprints[i].maxRobots[cost], blueprints[i].robotCosts[resource][cost])
-			}
-		}
+// blueprintTemplate describes the costs of the robots of a blueprint
+const blueprintTemplate = "Blueprint {id}: Each ore robot costs {oreOre} ore. Each clay robot costs {clayOre} ore. " +
+	"Each obsidian robot costs {obsidianOre} ore and {obsidianClay} clay. Each geode robot costs {geodeOre} ore and {geodeObsidian} obsidian."
+
+// parseBlueprint returns the blueprint described by the input
+func parseBlueprint(input string) (Blueprint, error) {
+	blueprint := Blueprint{}
+	if err := utils.Scan(input, blueprintTemplate,
+		&blueprint.ID,
+		&blueprint.robotCosts[ore][ore],
+		&blueprint.robotCosts[clay][ore],
+		&blueprint.robotCosts[obsidian][ore],
+		&blueprint.robotCosts[obsidian][clay],
+		&blueprint.robotCosts[geode][ore],
+		&blueprint.robotCosts[geode][obsidian],
+	); err != nil {
+		return blueprint, err
 	}
 
-	return blueprints
+	for cost := 0; cost < N; cost++ {
+		for resource := 0; resource < N; resource++ {
+			blueprint.maxRobots[cost] = utils.Max(blueprint.maxRobots[cost], blueprint.robotCosts[resource][cost])
+		}
+	}
+	return blueprint, nil
 }
 
-func main() {
-	fmt.Println("--- 2022 Day 19: Not Enough Minerals ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	blueprints := parseBlueprints(inputs)
-
-	////////////////////////////////////////
+func parseBlueprints(inputs []string) Blueprints {
+	return utils.Must(utils.MapLines(inputs, parseBlueprint))
+}
 
-	// 33
-	fmt.Println("Part 1:", blueprints.getQualityLevels(24))
+func part1(blueprints Blueprints) int {
+	return blueprints.getQualityLevels(24)
+}
 
-	////////////////////////////////////////
+func part2(blueprints Blueprints) int {
+	return blueprints.getMaxMultiplied(3, 32)
+}
 
-	// 3472
-	fmt.Println("Part 2:", blueprints.getMaxMultiplied(3, 32))
+func init() {
+	aoc.Register(aoc.Puzzle[Blueprints, int, int]{
+		Year:  2022,
+		Day:   19,
+		Title: "Not Enough Minerals",
+		Parse: aoc.Lines(parseBlueprints),
+		Part1: part1,
+		Part2: part2,
+	})
 }
@@ -0,0 +1,66 @@
+// https://adventofcode.com/2022/day/20
+
+package day20
+
+import (
+	"slices"
+
+	"github.com/aurelbec/advent-of-code/aoc"
+	"github.com/aurelbec/advent-of-code/utils"
+	"github.com/aurelbec/advent-of-code/utils/collections"
+)
+
+type Mix struct {
+	*collections.Sequence[int] // mixed values, rotated so that 0 is the first one
+}
+
+func (mix Mix) GetCoordinate(index int, _ ...int) int {
+	return mix.At(index % mix.Len())
+}
+
+func mixSequence(sequence []int, decryptionKey, steps int) Mix {
+	// keep the nodes in the original order, to find where each value is now
+	mix := collections.NewSequence[int]()
+	nodes := make([]*collections.SequenceNode[int], len(sequence))
+	for i, value := range sequence {
+		nodes[i] = mix.Insert(i, value*decryptionKey)
+	}
+
+	// mix the sequence, moving each value as many positions as its value, in the sequence without it
+	for step := 0; step < steps; step++ {
+		for _, node := range nodes {
+			from := mix.Remove(node)
+			mix.InsertNode(utils.Mod(from+node.Value, mix.Len()), node)
+		}
+	}
+
+	mix.Rotate(mix.Index(nodes[slices.Index(sequence, 0)]))
+	return Mix{mix}
+}
+
+func parseSequence(inputs []string) []int {
+	return utils.Must(utils.MapLines(inputs, utils.ParseInt))
+}
+
+var groveCoordinates = []int{1000, 2000, 3000}
+
+func part1(sequence []int) int {
+	mix := mixSequence(sequence, 1, 1)
+	return utils.SumFunc(groveCoordinates, mix.GetCoordinate)
+}
+
+func part2(sequence []int) int {
+	mix := mixSequence(sequence, 811589153, 10)
+	return utils.SumFunc(groveCoordinates, mix.GetCoordinate)
+}
+
+func init() {
+	aoc.Register(aoc.Puzzle[[]int, int, int]{
+		Year:  2022,
+		Day:   20,
+		Title: "Grove Positioning System",
+		Parse: aoc.Lines(parseSequence),
+		Part1: part1,
+		Part2: part2,
+	})
+}
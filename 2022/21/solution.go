@@ -1,12 +1,12 @@
 // https://adventofcode.com/2022/day/21
 
-package main
+package day21
 
 import (
 	"fmt"
 	"strings"
-	"time"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 )
 
@@ -87,11 +87,13 @@ func parseMonkeys(inputs []string) map[string]*Monkey {
 		monkeys[name] = &Monkey{name: name}
 	}
 
-	for _, monkey := range args {
+	for i, monkey := range args {
 		name := strings.TrimRight(monkey[0], ":")
 		switch args := monkey[1:]; {
 		case len(args) == 1:
-			monkeys[name].value = utils.MustInt(args[0])
+			if err := utils.Scan(inputs[i], "{_}: {value}", &monkeys[name].value); err != nil {
+				panic(fmt.Errorf("line %d: %w", i+1, err))
+			}
 		default:
 			monkeys[name].left = monkeys[args[0]]
 			monkeys[name].operation = args[1][0]
@@ -104,25 +106,21 @@ func parseMonkeys(inputs []string) map[string]*Monkey {
 	return monkeys
 }
 
-func main() {
-	fmt.Println("--- 2022 Day 21: Monkey Math ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	monkeys := parseMonkeys(inputs)
-
-	root := monkeys["root"]
-	humn := monkeys["humn"]
-
-	////////////////////////////////////////
-
-	// 152
-	fmt.Println("Part 1:", root.yell())
+func part1(monkeys map[string]*Monkey) int {
+	return monkeys["root"].yell()
+}
 
-	////////////////////////////////////////
+func part2(monkeys map[string]*Monkey) int {
+	return monkeys["humn"].yellFor(monkeys["root"])
+}
 
-	// 301
-	fmt.Println("Part 2:", humn.yellFor(root))
+func init() {
+	aoc.Register(aoc.Puzzle[map[string]*Monkey, int, int]{
+		Year:  2022,
+		Day:   21,
+		Title: "Monkey Math",
+		Parse: aoc.Lines(parseMonkeys),
+		Part1: part1,
+		Part2: part2,
+	})
 }
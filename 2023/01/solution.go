@@ -1,13 +1,11 @@
 // https://adventofcode.com/2023/day/1
 
-package main
+package day01
 
 import (
-	"fmt"
 	"slices"
-	"time"
 
-	"github.com/aurelbec/advent-of-code/utils"
+	"github.com/aurelbec/advent-of-code/aoc"
 )
 
 func parseDigit(word string) (int, bool) {
@@ -74,21 +72,21 @@ func getCalibrationValuesSum(calibrationValues [][2][2]int, digit int) int {
 	return sum
 }
 
-func main() {
-	fmt.Println("--- 2023 Day 1: Trebuchet?! ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs1 := utils.MustReadInput("example1.txt")
-	inputs2 := utils.MustReadInput("example2.txt")
-
-	////////////////////////////////////////
-
-	// 142
-	fmt.Println("Part 1:", getCalibrationValuesSum(parseCalibrationValues(inputs1), 0))
+func part1(calibrationValues [][2][2]int) int {
+	return getCalibrationValuesSum(calibrationValues, 0)
+}
 
-	////////////////////////////////////////
+func part2(calibrationValues [][2][2]int) int {
+	return getCalibrationValuesSum(calibrationValues, 1)
+}
 
-	// 281
-	fmt.Println("Part 2:", getCalibrationValuesSum(parseCalibrationValues(inputs2), 1))
+func init() {
+	aoc.Register(aoc.Puzzle[[][2][2]int, int, int]{
+		Year:  2023,
+		Day:   1,
+		Title: "Trebuchet?!",
+		Parse: aoc.Lines(parseCalibrationValues),
+		Part1: part1,
+		Part2: part2,
+	})
 }
@@ -1,14 +1,13 @@
 // https://adventofcode.com/2023/day/2
 
-package main
+package day02
 
 import (
 	"fmt"
 	"strconv"
 	"strings"
-	"time"
 
-	"github.com/aurelbec/advent-of-code/utils"
+	"github.com/aurelbec/advent-of-code/aoc"
 )
 
 type game struct {
@@ -65,22 +64,21 @@ func parseGames(inputs []string) []game {
 	return games
 }
 
-func main() {
-	fmt.Println("--- 2023 Day 2: Cube Conundrum ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	games := parseGames(inputs)
-
-	////////////////////////////////////////
-
-	// 8
-	fmt.Println("Part 1:", getGameIDs(games, 12, 13, 14))
+func part1(games []game) int {
+	return getGameIDs(games, 12, 13, 14)
+}
 
-	////////////////////////////////////////
+func part2(games []game) int {
+	return getGamePowers(games)
+}
 
-	// 2286
-	fmt.Println("Part 2:", getGamePowers(games))
+func init() {
+	aoc.Register(aoc.Puzzle[[]game, int, int]{
+		Year:  2023,
+		Day:   2,
+		Title: "Cube Conundrum",
+		Parse: aoc.Lines(parseGames),
+		Part1: part1,
+		Part2: part2,
+	})
 }
@@ -0,0 +1,116 @@
+// https://adventofcode.com/2023/day/3
+
+package day03
+
+import (
+	"slices"
+	"unicode"
+
+	"github.com/aurelbec/advent-of-code/aoc"
+	"github.com/aurelbec/advent-of-code/utils"
+)
+
+type engineSchematic struct {
+	cells  utils.Grid[byte]
+	digits utils.Grid[*partNumber] // part number each digit belongs to, nil if not a digit
+
+	partNumber []*partNumber
+	gears      []utils.Location2D[int]
+}
+
+type partNumber struct {
+	value int
+	valid bool
+}
+
+func isSymbol(b byte) bool {
+	return !unicode.IsDigit(rune(b)) && b != '.'
+}
+
+func (es engineSchematic) getPartNumbersSum() int {
+	sum := 0
+	for _, partNumber := range es.partNumber {
+		if partNumber.valid {
+			sum += partNumber.value
+		}
+	}
+	return sum
+}
+
+func (es engineSchematic) getGearRatiosSum() int {
+	sum := 0
+	for _, gear := range es.gears {
+		partNumbers := make(map[*partNumber]struct{}, 3)
+		for _, neighbour := range es.digits.Neighbours8(gear) {
+			partNumbers[es.digits.At(neighbour)] = struct{}{}
+		}
+
+		delete(partNumbers, nil)
+		if len(partNumbers) == 2 {
+			ratio := 1
+			for partNumber := range partNumbers {
+				ratio *= partNumber.value
+			}
+			sum += ratio
+		}
+	}
+	return sum
+}
+
+func (es engineSchematic) isAdjacentToSymbol(location utils.Location2D[int]) bool {
+	return slices.ContainsFunc(es.cells.Neighbours8(location), func(neighbour utils.Location2D[int]) bool {
+		return isSymbol(es.cells.At(neighbour))
+	})
+}
+
+func parseEngineSchematic(inputs []string) engineSchematic {
+	cells := utils.ParseByteGrid(inputs)
+	engineSchematic := engineSchematic{
+		cells:  cells,
+		digits: utils.NewGrid[*partNumber](cells.Width, cells.Height),
+	}
+
+	pn := &partNumber{}
+	for y := 0; y < cells.Height; y++ {
+		for x, b := range cells.Row(y) {
+			location := utils.NewLocation2D(x, y)
+			if b == '*' {
+				engineSchematic.gears = append(engineSchematic.gears, location)
+			}
+			if unicode.IsDigit(rune(b)) {
+				pn.value = pn.value*10 + int(b-'0')
+				pn.valid = pn.valid || engineSchematic.isAdjacentToSymbol(location)
+				engineSchematic.digits.Set(location, pn)
+			} else if pn.value > 0 {
+				engineSchematic.partNumber = append(engineSchematic.partNumber, pn)
+				pn = &partNumber{}
+			}
+		}
+
+		if pn.value > 0 {
+			engineSchematic.partNumber = append(engineSchematic.partNumber, pn)
+			pn = &partNumber{}
+		}
+	}
+
+	return engineSchematic
+}
+
+func part1(engineSchematic engineSchematic) int {
+	return engineSchematic.getPartNumbersSum()
+}
+
+func part2(engineSchematic engineSchematic) int {
+	return engineSchematic.getGearRatiosSum()
+}
+
+func init() {
+	aoc.Register(aoc.Puzzle[engineSchematic, int, int]{
+		Year:  2023,
+		Day:   3,
+		Title: "Gear Ratios",
+		Parse: aoc.Lines(parseEngineSchematic),
+		Part1: part1,
+		Part2: part2,
+	})
+}
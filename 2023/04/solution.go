@@ -1,12 +1,11 @@
 // https://adventofcode.com/2023/day/4
 
-package main
+package day04
 
 import (
-	"fmt"
 	"strings"
-	"time"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 )
 
@@ -85,23 +84,21 @@ func parsePile(inputs []string) pile {
 	return pile
 }
 
-func main() {
-	fmt.Println("--- 2023 Day 4: Scratchcards ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	pile := parsePile(inputs)
-
-	////////////////////////////////////////
-
-	// 13
-	fmt.Println("Part 1:", pile.getPoints())
-	//
+func part1(pile pile) int {
+	return pile.getPoints()
+}
 
-	////////////////////////////////////////
+func part2(pile pile) int {
+	return pile.getTotalCards()
+}
 
-	// 30
-	fmt.Println("Part 2:", pile.getTotalCards())
+func init() {
+	aoc.Register(aoc.Puzzle[pile, int, int]{
+		Year:  2023,
+		Day:   4,
+		Title: "Scratchcards",
+		Parse: aoc.Lines(parsePile),
+		Part1: part1,
+		Part2: part2,
+	})
 }
@@ -1,14 +1,13 @@
 // https://adventofcode.com/2023/day/5
 
-package main
+package day05
 
 import (
 	"fmt"
 	"math"
 	"sort"
-	"strings"
-	"time"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 )
 
@@ -67,70 +66,59 @@ func (almanac Almanac) getLowestLocation(sources utils.Intervals[int]) int {
 	return res
 }
 
-func parseAlmanac(inputs []string) Almanac {
-	destination, source, length := 0, 0, 0
-	almanac := Almanac{}
-
-	for _, line := range inputs {
-		switch {
-		// get seeds list
-		case len(almanac.seeds) == 0 && strings.HasPrefix(line, "seeds:"):
-			almanac.seeds = utils.ArrayMap(utils.Numbers(line), utils.MustInt)
-
-		// register new mapping
-		case strings.HasSuffix(line, "map:"):
-			almanac.mappings = append(almanac.mappings, Mapping{})
-			fmt.Sscanf(line, "%s map:", &almanac.mappings[len(almanac.mappings)-1].name)
-
-		// end map, do nothing
-		case line == "":
-
-		// add range to the last mapping
-		default:
-			fmt.Sscanf(line, "%d %d %d", &destination, &source, &length)
-			almanac.mappings[len(almanac.mappings)-1].ranges = append(
-				almanac.mappings[len(almanac.mappings)-1].ranges,
-				Range{source: utils.NewInterval(source, source+length-1), offset: destination - source},
-			)
-		}
+// parseMapping returns the mapping described by a section, its ranges being ordered by their source
+func parseMapping(section utils.Section) (Mapping, error) {
+	mapping := Mapping{}
+	if _, err := fmt.Sscanf(section.Lines[0], "%s map:", &mapping.name); err != nil {
+		return mapping, section.Errorf(0, "invalid mapping header %q: %w", section.Lines[0], err)
 	}
 
-	// order mapping ranges by their source
-	for m := range almanac.mappings {
-		sort.Slice(almanac.mappings[m].ranges, func(i, j int) bool {
-			return almanac.mappings[m].ranges[i].source.Min < almanac.mappings[m].ranges[j].source.Min
-		})
+	for i, line := range section.Lines[1:] {
+		destination, source, length := 0, 0, 0
+		if _, err := fmt.Sscanf(line, "%d %d %d", &destination, &source, &length); err != nil {
+			return mapping, section.Errorf(i+1, "invalid range %q: %w", line, err)
+		}
+		mapping.ranges = append(mapping.ranges, Range{source: utils.NewInterval(source, source+length-1), offset: destination - source})
 	}
 
-	return almanac
+	sort.Slice(mapping.ranges, func(i, j int) bool {
+		return mapping.ranges[i].source.Min < mapping.ranges[j].source.Min
+	})
+	return mapping, nil
 }
 
-func main() {
-	fmt.Println("--- 2023 Day 5: If You Give A Seed A Fertilizer ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	almanac := parseAlmanac(inputs)
-
-	////////////////////////////////////////
+// parseAlmanac returns the seeds, from the first section, followed by the mappings
+func parseAlmanac(inputs []string) Almanac {
+	sections := utils.Must(utils.SplitSections(inputs, "seeds"))
+	return Almanac{
+		seeds:    utils.Ints(sections.Get("seeds").Lines[0]),
+		mappings: utils.Must(utils.ParseSections(sections[1:], parseMapping)),
+	}
+}
 
+func part1(almanac Almanac) int {
 	intervals := utils.Intervals[int]{}
 	for i := 0; i < len(almanac.seeds); i += 1 {
 		intervals.Insert(utils.NewInterval(almanac.seeds[i], almanac.seeds[i]))
 	}
+	return almanac.getLowestLocation(intervals)
+}
 
-	// 35
-	fmt.Println("Part 1:", almanac.getLowestLocation(intervals))
-
-	////////////////////////////////////////
-
-	intervals = utils.Intervals[int]{}
+func part2(almanac Almanac) int {
+	intervals := utils.Intervals[int]{}
 	for i := 0; i < len(almanac.seeds); i += 2 {
 		intervals.Insert(utils.NewInterval(almanac.seeds[i], almanac.seeds[i]+almanac.seeds[i+1]-1))
 	}
+	return almanac.getLowestLocation(intervals)
+}
 
-	// 46
-	fmt.Println("Part 2:", almanac.getLowestLocation(intervals))
+func init() {
+	aoc.Register(aoc.Puzzle[Almanac, int, int]{
+		Year:  2023,
+		Day:   5,
+		Title: "If You Give A Seed A Fertilizer",
+		Parse: aoc.Lines(parseAlmanac),
+		Part1: part1,
+		Part2: part2,
+	})
 }
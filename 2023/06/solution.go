@@ -1,13 +1,13 @@
 // https://adventofcode.com/2023/day/6
 
-package main
+package day06
 
 import (
 	"fmt"
 	"math"
 	"strings"
-	"time"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 )
 
@@ -32,12 +32,12 @@ func concatenateRaces(races []Race) Race {
 		time += fmt.Sprint(race.time)
 		distance += fmt.Sprint(race.distance)
 	}
-	return Race{time: utils.MustInt(time), distance: utils.MustInt(distance)}
+	return Race{time: utils.Must(utils.ParseInt(time)), distance: utils.Must(utils.ParseInt(distance))}
 }
 
 func parseRaces(inputs []string) []Race {
-	times := utils.ArrayMap(utils.Numbers(strings.TrimPrefix(inputs[0], "Time:")), utils.MustInt)
-	distances := utils.ArrayMap(utils.Numbers(strings.TrimPrefix(inputs[1], "Distance:")), utils.MustInt)
+	times := utils.Ints(strings.TrimPrefix(inputs[0], "Time:"))
+	distances := utils.Ints(strings.TrimPrefix(inputs[1], "Distance:"))
 	n := min(len(times), len(distances))
 	races := make([]Race, n)
 	for i := 0; i < n; i++ {
@@ -47,22 +47,21 @@ func parseRaces(inputs []string) []Race {
 	return races
 }
 
-func main() {
-	fmt.Println("--- 2023 Day 6: Wait For It ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	races := parseRaces(inputs)
-
-	////////////////////////////////////////
-
-	// 288
-	fmt.Println("Part 1:", utils.MultiplyFunc(races, Race.numberOfWaysToWin))
+func part1(races []Race) int {
+	return utils.MultiplyFunc(races, Race.numberOfWaysToWin)
+}
 
-	////////////////////////////////////////
+func part2(races []Race) int {
+	return concatenateRaces(races).numberOfWaysToWin(0)
+}
 
-	// 71503
-	fmt.Println("Part 2:", concatenateRaces(races).numberOfWaysToWin(0))
+func init() {
+	aoc.Register(aoc.Puzzle[[]Race, int, int]{
+		Year:  2023,
+		Day:   6,
+		Title: "Wait For It",
+		Parse: aoc.Lines(parseRaces),
+		Part1: part1,
+		Part2: part2,
+	})
 }
@@ -1,12 +1,12 @@
 // https://adventofcode.com/2023/day/7
 
-package main
+package day07
 
 import (
 	"fmt"
 	"slices"
-	"time"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 )
 
@@ -73,16 +73,14 @@ func parseHands(inputs []string) []Hand {
 	for i, input := range inputs {
 		fmt.Sscanf(input, "%s %v", &hands[i].cards, &hands[i].bid)
 
-		cardsMap := make(map[rune]int)
-		maxRepeatingCards := 0
 		for _, card := range hands[i].cards {
-			cardsMap[card]++
-			maxRepeatingCards = max(maxRepeatingCards, cardsMap[card])
 			hands[i].values[0] = hands[i].values[0]<<4 + cardValues[card][0]
 			hands[i].values[1] = hands[i].values[1]<<4 + cardValues[card][1]
 		}
 
-		n, j := len(cardsMap), cardsMap['J']
+		cards := utils.NewCounter([]rune(hands[i].cards)...)
+		maxRepeatingCards := cards.MostCommon(1)[0].Count
+		n, j := cards.Len(), cards.Count('J')
 		switch {
 		case maxRepeatingCards == 5 && n == 1:
 			hands[i].kind = [2]int{FiveOfAKind, FiveOfAKind}
@@ -108,26 +106,23 @@ func parseHands(inputs []string) []Hand {
 	return hands
 }
 
-func main() {
-	fmt.Println("--- 2023 Day 7: Camel Cards ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	hands := parseHands(inputs)
-
-	////////////////////////////////////////
-
+func part1(hands []Hand) int {
 	slices.SortFunc(hands, Hand.compareJack)
+	return utils.SumFunc(hands, Hand.totalWinning)
+}
 
-	// 6440
-	fmt.Println("Part 1:", utils.SumFunc(hands, Hand.totalWinning))
-
-	////////////////////////////////////////
-
+func part2(hands []Hand) int {
 	slices.SortFunc(hands, Hand.compareJoker)
+	return utils.SumFunc(hands, Hand.totalWinning)
+}
 
-	// 5905
-	fmt.Println("Part 2:", utils.SumFunc(hands, Hand.totalWinning))
+func init() {
+	aoc.Register(aoc.Puzzle[[]Hand, int, int]{
+		Year:  2023,
+		Day:   7,
+		Title: "Camel Cards",
+		Parse: aoc.Lines(parseHands),
+		Part1: part1,
+		Part2: part2,
+	})
 }
@@ -1,11 +1,11 @@
 // https://adventofcode.com/2023/day/8
 
-package main
+package day08
 
 import (
-	"fmt"
-	"time"
+	"regexp"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 )
 
@@ -16,6 +16,9 @@ const (
 	Right = 'R'
 )
 
+// node labels may contain digits (e.g. 11A), so utils.Words can not be used
+var extractLabels = regexp.MustCompile(`[0-9A-Z]+`)
+
 type node struct {
 	last byte
 	next [2]*node
@@ -54,7 +57,7 @@ func parseNodes(inputs []string) map[string]*node {
 	// create nodes from inputs
 	links := make([][3]string, 0, len(inputs))
 	for _, input := range inputs {
-		if input := utils.Words(input); len(input) == 3 {
+		if input := extractLabels.FindAllString(input, -1); len(input) == 3 {
 			links = append(links, [3]string(input))
 			nodes[input[0]] = &node{last: input[0][2]}
 			nodes[input[1]] = &node{last: input[1][2]}
@@ -71,22 +74,26 @@ func parseNodes(inputs []string) map[string]*node {
 	return nodes
 }
 
-func main() {
-	fmt.Println("--- 2023 Day 8: Haunted Wasteland ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	costs := getCosts(parseNodes(inputs), inputs[0])
-
-	////////////////////////////////////////
+// parseCosts returns the number of steps needed from each starting node to reach its destination
+func parseCosts(inputs []string) map[string]int {
+	return getCosts(parseNodes(inputs), inputs[0])
+}
 
-	// 2
-	fmt.Println("Part 1:", utils.LCM(costs["AAA"]))
+func part1(costs map[string]int) int {
+	return utils.LCM(costs["AAA"])
+}
 
-	////////////////////////////////////////
+func part2(costs map[string]int) int {
+	return utils.LCM(utils.MapValues(costs)...)
+}
 
-	// 6
-	fmt.Println("Part 2:", utils.LCM(utils.MapValues(costs)...))
+func init() {
+	aoc.Register(aoc.Puzzle[map[string]int, int, int]{
+		Year:  2023,
+		Day:   8,
+		Title: "Haunted Wasteland",
+		Parse: aoc.Lines(parseCosts),
+		Part1: part1,
+		Part2: part2,
+	})
 }
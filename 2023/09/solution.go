@@ -1,12 +1,11 @@
 // https://adventofcode.com/2023/day/9
 
-package main
+package day09
 
 import (
-	"fmt"
 	"slices"
-	"time"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 )
 
@@ -33,25 +32,24 @@ func getPastValue(history []int, _ ...int) (extrapolation int) {
 }
 
 func parseHistories(inputs []string) [][]int {
-	return utils.ArrayMap(inputs, utils.FastNumbers)
+	return utils.Must(utils.ParseIntLines(inputs))
 }
 
-func main() {
-	fmt.Println("--- 2023 Day 9: Mirage Maintenance ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	histories := parseHistories(inputs)
-
-	////////////////////////////////////////
-
-	// 114
-	fmt.Println("Part 1:", utils.SumFunc(histories, getNextValue))
+func part1(histories [][]int) int {
+	return utils.SumFunc(histories, getNextValue)
+}
 
-	////////////////////////////////////////
+func part2(histories [][]int) int {
+	return utils.SumFunc(histories, getPastValue)
+}
 
-	// 2
-	fmt.Println("Part 2:", utils.SumFunc(histories, getPastValue))
+func init() {
+	aoc.Register(aoc.Puzzle[[][]int, int, int]{
+		Year:  2023,
+		Day:   9,
+		Title: "Mirage Maintenance",
+		Parse: aoc.Lines(parseHistories),
+		Part1: part1,
+		Part2: part2,
+	})
 }
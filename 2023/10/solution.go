@@ -1,12 +1,12 @@
 // https://adventofcode.com/2023/day/10
 
-package main
+package day10
 
 import (
 	"fmt"
 	"slices"
-	"time"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 	"github.com/aurelbec/advent-of-code/utils/collections"
 )
@@ -33,14 +33,12 @@ var conversion = map[byte]int{'|': NS, '-': EW, '7': SW, 'J': NW, 'F': SE, 'L':
 
 type Tile struct {
 	kind int
-	x, y int
+	loc  utils.Location2D[int]
 	next []*Tile
 }
 
 type Network struct {
-	dims utils.Location2D[int]
-
-	tiles [][]*Tile
+	tiles utils.Grid[*Tile]
 	start *Tile
 }
 
@@ -53,7 +51,7 @@ func (n Network) getLongestLoop(start *Tile) []*Tile {
 
 	var part1, part2 *node
 
-	visitedTiles := make(map[*Tile]*node, n.dims.X*n.dims.Y)
+	visitedTiles := make(map[*Tile]*node, n.tiles.Width*n.tiles.Height)
 	queue := collections.NewQueue(&node{start, nil, 0})
 	for {
 		current, found := queue.Dequeue()
@@ -93,29 +91,25 @@ func (n Network) getLongestLoop(start *Tile) []*Tile {
 }
 
 func (n Network) getTilesInLoop(loop []*Tile) []*Tile {
-	isLoop := make(map[*Tile]bool, len(loop))
-	for _, tile := range loop {
-		isLoop[tile] = true
-	}
+	isLoop := utils.NewSet(loop...)
 
 	insideLoop := make([]*Tile, 0)
-	for x := 0; x < n.dims.X; x++ {
+	for x := 0; x < n.tiles.Width; x++ {
 		isInsideLoop := false
 		lastBend := 0
-		for y := 0; y < n.dims.Y; y++ {
-			tile := n.tiles[x][y]
+		for _, tile := range n.tiles.ColumnCopy(x) {
 
 			// switch loop if pipe direction changed to up/down
-			isInsideLoop = isInsideLoop != (isLoop[tile] &&
+			isInsideLoop = isInsideLoop != (isLoop.Has(tile) &&
 				((tile.kind == EW) || (lastBend == SW && tile.kind == NE) || (lastBend == SE && tile.kind == NW)))
 
 			// keep trace of last corner in row
-			if isLoop[tile] && (tile.kind != NS && tile.kind != EW) {
+			if isLoop.Has(tile) && (tile.kind != NS && tile.kind != EW) {
 				lastBend = tile.kind
 			}
 
 			// tile inside loop found
-			if isInsideLoop && !isLoop[tile] {
+			if isInsideLoop && !isLoop.Has(tile) {
 				insideLoop = append(insideLoop, tile)
 			}
 		}
@@ -128,25 +122,16 @@ func (n Network) print() {
 	beautify := map[int]string{NE: "└", SE: "┌", SW: "┐", NW: "┘", NS: "│", EW: "─"}
 
 	longestLoop := n.getLongestLoop(n.start)
-	isOnLoop := make(map[*Tile]bool, len(longestLoop))
-	for _, tile := range longestLoop {
-		isOnLoop[tile] = true
-	}
+	isOnLoop := utils.NewSet(longestLoop...)
+	isInLoop := utils.NewSet(n.getTilesInLoop(longestLoop)...)
 
-	tilesInLoop := n.getTilesInLoop(longestLoop)
-	isInLoop := make(map[*Tile]bool, len(tilesInLoop))
-	for _, tile := range tilesInLoop {
-		isInLoop[tile] = true
-	}
-
-	for y := 0; y < n.dims.Y; y++ {
-		for x := 0; x < n.dims.X; x++ {
-			tile := n.tiles[x][y]
+	for y := 0; y < n.tiles.Height; y++ {
+		for _, tile := range n.tiles.Row(y) {
 			if tile == n.start {
 				fmt.Print("S")
-			} else if isOnLoop[tile] {
+			} else if isOnLoop.Has(tile) {
 				fmt.Print(beautify[tile.kind])
-			} else if isInLoop[tile] {
+			} else if isInLoop.Has(tile) {
 				fmt.Print("░")
 			} else {
 				fmt.Print(" ")
@@ -157,48 +142,37 @@ func (n Network) print() {
 }
 
 func parseNetwork(inputs []string) Network {
-	network := Network{dims: utils.NewLocation2D(len(inputs[0]), len(inputs))}
-
-	network.tiles = make([][]*Tile, network.dims.X)
-	for x := 0; x < network.dims.X; x++ {
-		network.tiles[x] = make([]*Tile, network.dims.Y)
-		for y := 0; y < network.dims.Y; y++ {
-			tile := inputs[y][x]
-			network.tiles[x][y] = &Tile{conversion[tile], x, y, nil}
-			if tile == 'S' {
-				network.start = network.tiles[x][y]
-			}
+	inputs = utils.Must(utils.Validate(inputs, utils.Rectangular(), utils.Alphabet("|-LJ7F.S"), utils.ExactlyOne('S')))
+
+	network := Network{}
+	network.tiles = utils.ParseGrid(inputs, func(b byte) *Tile { return &Tile{kind: conversion[b]} })
+	network.tiles.Each(func(loc utils.Location2D[int], tile *Tile) {
+		tile.loc = loc
+		if inputs[loc.Y][loc.X] == 'S' {
+			network.start = tile
 		}
-	}
 
-	for x, line := range network.tiles {
-		for y, tile := range line {
-			if x > 0 {
-				if left := network.tiles[x-1][y]; (tile.kind&W) > 0 && (left.kind&E) > 0 {
-					tile.next = append(tile.next, left)
-					left.next = append(left.next, tile)
-				}
-			}
-			if y > 0 {
-				if up := network.tiles[x][y-1]; (tile.kind&N) > 0 && (up.kind&S) > 0 {
-					tile.next = append(tile.next, up)
-					up.next = append(up.next, tile)
-				}
-			}
+		if left, found := network.tiles.Get(loc.MovedBy(-1, 0)); found && (tile.kind&W) > 0 && (left.kind&E) > 0 {
+			tile.next = append(tile.next, left)
+			left.next = append(left.next, tile)
 		}
-	}
+		if up, found := network.tiles.Get(loc.MovedBy(0, -1)); found && (tile.kind&N) > 0 && (up.kind&S) > 0 {
+			tile.next = append(tile.next, up)
+			up.next = append(up.next, tile)
+		}
+	})
 
 	// infer start kind
 	network.start.kind = 0
 	for _, next := range network.start.next {
 		switch {
-		case next.kind&S > 0 && network.start.y > next.y && network.start.x == next.x:
+		case next.kind&S > 0 && network.start.loc.Y > next.loc.Y && network.start.loc.X == next.loc.X:
 			network.start.kind |= N
-		case next.kind&N > 0 && network.start.y < next.y && network.start.x == next.x:
+		case next.kind&N > 0 && network.start.loc.Y < next.loc.Y && network.start.loc.X == next.loc.X:
 			network.start.kind |= S
-		case next.kind&E > 0 && network.start.x > next.x && network.start.y == next.y:
+		case next.kind&E > 0 && network.start.loc.X > next.loc.X && network.start.loc.Y == next.loc.Y:
 			network.start.kind |= W
-		case next.kind&W > 0 && network.start.x < next.x && network.start.y == next.y:
+		case next.kind&W > 0 && network.start.loc.X < next.loc.X && network.start.loc.Y == next.loc.Y:
 			network.start.kind |= E
 		}
 	}
@@ -206,28 +180,23 @@ func parseNetwork(inputs []string) Network {
 	return network
 }
 
-func main() {
-	fmt.Println("--- 2023 Day 10: Pipe Maze ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	network := parseNetwork(inputs)
-
-	////////////////////////////////////////
-
+func part1(network Network) int {
 	longestLoop := network.getLongestLoop(network.start)
+	return (len(longestLoop) - 1) / 2
+}
 
-	// 80
-	fmt.Println("Part 1:", (len(longestLoop)-1)/2)
-
-	////////////////////////////////////////
-
-	tilesInLoop := network.getTilesInLoop(longestLoop)
-
-	// 10
-	fmt.Println("Part 2:", len(tilesInLoop))
+func part2(network Network) int {
+	longestLoop := network.getLongestLoop(network.start)
+	return len(network.getTilesInLoop(longestLoop))
+}
 
-	network.print()
+func init() {
+	aoc.Register(aoc.Puzzle[Network, int, int]{
+		Year:  2023,
+		Day:   10,
+		Title: "Pipe Maze",
+		Parse: aoc.Lines(parseNetwork),
+		Part1: part1,
+		Part2: part2,
+	})
 }
@@ -1,11 +1,9 @@
 // https://adventofcode.com/2023/day/11
 
-package main
+package day11
 
 import (
-	"fmt"
-	"time"
-
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 )
 
@@ -63,24 +61,24 @@ func parseUniverse(inputs []string) Universe {
 	return universe
 }
 
-func main() {
-	fmt.Println("--- 2023 Day 11: Cosmic Expansion ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	universe := parseUniverse(inputs)
-
-	////////////////////////////////////////
-
-	// 374
-	fmt.Println("Part 1:", utils.Sum(universe.getGalaxiesDistances(2)))
+func part1(universe Universe) int {
+	return utils.Sum(universe.getGalaxiesDistances(2))
+}
 
-	////////////////////////////////////////
+// 10: 1030
+// 100: 8410
+// 1000000: 82000210
+func part2(universe Universe) int {
+	return utils.Sum(universe.getGalaxiesDistances(1_000_000))
+}
 
-	// 10: 1030
-	// 100: 8410
-	// 1000000: 82000210
-	fmt.Println("Part 2:", utils.Sum(universe.getGalaxiesDistances(1_000_000)))
+func init() {
+	aoc.Register(aoc.Puzzle[Universe, int, int]{
+		Year:  2023,
+		Day:   11,
+		Title: "Cosmic Expansion",
+		Parse: aoc.Lines(parseUniverse),
+		Part1: part1,
+		Part2: part2,
+	})
 }
@@ -0,0 +1,98 @@
+// https://adventofcode.com/2023/day/12
+
+package day12
+
+import (
+	"strings"
+
+	"github.com/aurelbec/advent-of-code/aoc"
+	"github.com/aurelbec/advent-of-code/utils"
+)
+
+// see https://github.com/jonathanpaulson/AdventOfCode/blob/master/2023/12.py
+
+type tuple struct{ r, b, current int }
+
+type Record struct {
+	record string
+	blocks []int
+}
+
+func (r Record) getArrangementsCount(...int) int {
+	return countArrangements(r.record, r.blocks)
+}
+
+func (r Record) getUnfoldedArrangementsCount(...int) int {
+	var record string
+	var blocks []int
+	for i := 0; i < 5; i++ {
+		record += "?" + r.record
+		blocks = append(blocks, r.blocks...)
+	}
+	record = record[1:]
+
+	return countArrangements(record, blocks)
+}
+
+// countArrangements counts the ways of matching the blocks in the record, caching the counts from each position
+func countArrangements(record string, blocks []int) int {
+	var memo *utils.Memo[tuple, int]
+	memo = utils.NewMemo(func(key tuple) int {
+		recordPos, blockPos, current := key.r, key.b, key.current
+		if recordPos == len(record) {
+			if blockPos == len(blocks) && current == 0 {
+				return 1
+			} else if blockPos == len(blocks)-1 && blocks[blockPos] == current {
+				return 1
+			} else {
+				return 0
+			}
+		}
+
+		count := 0
+		for _, c := range []byte{'.', '#'} {
+			if record[recordPos] != c && record[recordPos] != '?' {
+				continue
+			}
+
+			if c == '.' && current == 0 {
+				count += memo.Get(tuple{recordPos + 1, blockPos, 0})
+			} else if c == '.' && current > 0 && blockPos < len(blocks) && blocks[blockPos] == current {
+				count += memo.Get(tuple{recordPos + 1, blockPos + 1, 0})
+			} else if c == '#' {
+				count += memo.Get(tuple{recordPos + 1, blockPos, current + 1})
+			}
+		}
+		return count
+	})
+	return memo.Get(tuple{0, 0, 0})
+}
+
+func parseRecords(inputs []string) []Record {
+	records := make([]Record, len(inputs))
+	for i, input := range inputs {
+		record, list, _ := strings.Cut(input, " ")
+		records[i].record = record
+		records[i].blocks = utils.Must(utils.ParseInts(list))
+	}
+	return records
+}
+
+func part1(records []Record) int {
+	return utils.SumFunc(records, Record.getArrangementsCount)
+}
+
+func part2(records []Record) int {
+	return utils.SumFunc(records, Record.getUnfoldedArrangementsCount)
+}
+
+func init() {
+	aoc.Register(aoc.Puzzle[[]Record, int, int]{
+		Year:  2023,
+		Day:   12,
+		Title: "Hot Springs",
+		Parse: aoc.Lines(parseRecords),
+		Part1: part1,
+		Part2: part2,
+	})
+}
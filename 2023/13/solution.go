@@ -1,11 +1,9 @@
 // https://adventofcode.com/2023/day/13
 
-package main
+package day13
 
 import (
-	"fmt"
-	"time"
-
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 )
 
@@ -59,35 +57,26 @@ func equals(a, b []byte, tolerance *int) bool {
 }
 
 func parsePatterns(inputs []string) []Pattern {
-	patterns := make([]Pattern, 1)
-
-	for _, input := range inputs {
-		if input == "" {
-			patterns = append(patterns, Pattern{})
-		} else {
-			patterns[len(patterns)-1] = append(patterns[len(patterns)-1], []byte(input))
-		}
-	}
-
-	return patterns
+	return utils.Must(utils.MapSections(inputs, func(section utils.Section) (Pattern, error) {
+		return utils.ArrayMap(section.Lines, func(line string) []byte { return []byte(line) }), nil
+	}))
 }
 
-func main() {
-	fmt.Println("--- 2023 Day 13: Point of Incidence ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	patterns := parsePatterns(inputs)
-
-	////////////////////////////////////////
-
-	// 405
-	fmt.Println("Part 1:", utils.SumFunc(patterns, func(p Pattern, _ ...int) int { return p.getSummary(0) }))
+func part1(patterns []Pattern) int {
+	return utils.SumFunc(patterns, func(p Pattern, _ ...int) int { return p.getSummary(0) })
+}
 
-	////////////////////////////////////////
+func part2(patterns []Pattern) int {
+	return utils.SumFunc(patterns, func(p Pattern, _ ...int) int { return p.getSummary(1) })
+}
 
-	// 400
-	fmt.Println("Part 2:", utils.SumFunc(patterns, func(p Pattern, _ ...int) int { return p.getSummary(1) }))
+func init() {
+	aoc.Register(aoc.Puzzle[[]Pattern, int, int]{
+		Year:  2023,
+		Day:   13,
+		Title: "Point of Incidence",
+		Parse: aoc.Lines(parsePatterns),
+		Part1: part1,
+		Part2: part2,
+	})
 }
@@ -0,0 +1,96 @@
+// https://adventofcode.com/2023/day/14
+
+package day14
+
+import (
+	"github.com/aurelbec/advent-of-code/aoc"
+	"github.com/aurelbec/advent-of-code/utils"
+)
+
+const (
+	roundedRock = 'O'
+	cubeRock    = '#'
+	emptySpace  = '.'
+)
+
+type Platform struct {
+	rocks utils.Grid[byte]
+}
+
+func (p *Platform) rollCycles(n int) {
+	cache := make(map[string]int)
+	for ; n > 0; n-- {
+		for i := 0; i < 4; i++ {
+			p.rollNorth()
+			p.rocks = p.rocks.RotateClockwise()
+		}
+
+		state := p.String()
+		if last, found := cache[state]; !found {
+			cache[state] = n
+		} else {
+			n %= (last - n)
+		}
+	}
+}
+
+func (p *Platform) rollNorth() {
+	for x := 0; x < p.rocks.Width; x++ {
+		freeY := p.rocks.Height
+		for y := 0; y < p.rocks.Height; y++ {
+			rock, free := utils.NewLocation2D(x, y), utils.NewLocation2D(x, freeY)
+			switch p.rocks.At(rock) {
+			case roundedRock:
+				if freeY != y && freeY < p.rocks.Height {
+					p.rocks.Set(free, roundedRock)
+					p.rocks.Set(rock, emptySpace)
+					freeY++
+				}
+			case cubeRock:
+				freeY = p.rocks.Height
+			default:
+				freeY = min(freeY, y)
+			}
+		}
+	}
+}
+
+func (p Platform) getNorthLoad() int {
+	totalLoad := 0
+	p.rocks.Each(func(location utils.Location2D[int], rock byte) {
+		if rock == roundedRock {
+			totalLoad += p.rocks.Height - location.Y
+		}
+	})
+	return totalLoad
+}
+
+func (p Platform) String() string {
+	return p.rocks.String()
+}
+
+func parsePlatform(inputs []string) Platform {
+	inputs = utils.Must(utils.Validate(inputs, utils.Rectangular(), utils.Alphabet(string([]byte{roundedRock, cubeRock, emptySpace}))))
+	return Platform{rocks: utils.ParseByteGrid(inputs)}
+}
+
+func part1(platform Platform) int {
+	platform.rollNorth()
+	return platform.getNorthLoad()
+}
+
+func part2(platform Platform) int {
+	platform.rollCycles(1_000_000_000)
+	return platform.getNorthLoad()
+}
+
+func init() {
+	aoc.Register(aoc.Puzzle[Platform, int, int]{
+		Year:  2023,
+		Day:   14,
+		Title: "Parabolic Reflector Dish",
+		Parse: aoc.Lines(parsePlatform),
+		Part1: part1,
+		Part2: part2,
+	})
+}
@@ -1,13 +1,13 @@
 // https://adventofcode.com/2023/day/15
 
-package main
+package day15
 
 import (
 	"fmt"
 	"slices"
 	"strings"
-	"time"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 )
 
@@ -46,12 +46,16 @@ func fillBoxes(steps []string) map[int]map[string]Lens {
 		case 1:
 			delete(boxes[box], label)
 		case 2:
+			focal, err := utils.ParseInt(values[1])
+			if err != nil {
+				panic(fmt.Errorf("step %d %q: %w", i+1, step, err))
+			}
 			if len(boxes[box]) == 0 {
-				boxes[box] = map[string]Lens{label: {focal: utils.MustInt(values[1]), insertedAt: i}}
+				boxes[box] = map[string]Lens{label: {focal: focal, insertedAt: i}}
 			} else if lens, found := boxes[box][label]; !found {
-				boxes[box][label] = Lens{focal: utils.MustInt(values[1]), insertedAt: i}
+				boxes[box][label] = Lens{focal: focal, insertedAt: i}
 			} else {
-				lens.focal = utils.MustInt(values[1])
+				lens.focal = focal
 				boxes[box][label] = lens
 			}
 		}
@@ -67,22 +71,21 @@ func parseSteps(inputs []string) []string {
 	return steps
 }
 
-func main() {
-	fmt.Println("--- 2023 Day 15: Lens Library ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	steps := parseSteps(inputs)
-
-	////////////////////////////////////////
-
-	// 1320
-	fmt.Println("Part 1:", utils.SumFunc(steps, getHash))
+func part1(steps []string) int {
+	return utils.SumFunc(steps, getHash)
+}
 
-	////////////////////////////////////////
+func part2(steps []string) int {
+	return getFocusingPower(fillBoxes(steps))
+}
 
-	// 145
-	fmt.Println("Part 2:", getFocusingPower(fillBoxes(steps)))
+func init() {
+	aoc.Register(aoc.Puzzle[[]string, int, int]{
+		Year:  2023,
+		Day:   15,
+		Title: "Lens Library",
+		Parse: aoc.Lines(parseSteps),
+		Part1: part1,
+		Part2: part2,
+	})
 }
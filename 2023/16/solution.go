@@ -1,12 +1,9 @@
 // https://adventofcode.com/2023/day/16
 
-package main
+package day16
 
 import (
-	"fmt"
-	"time"
-
-	"github.com/aurelbec/advent-of-code/utils"
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils/collections"
 )
 
@@ -88,23 +85,11 @@ func parseLayout(inputs []string) Layout {
 	return layout
 }
 
-func main() {
-	fmt.Println("--- 2023 Day 16: The Floor Will Be Lava ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	layout := parseLayout(inputs)
-
-	////////////////////////////////////////
-
-	// 46
-	fmt.Println("Part 1:", layout.energize(0, 0, R))
-
-	////////////////////////////////////////
+func part1(layout Layout) int {
+	return layout.energize(0, 0, R)
+}
 
-	// GHDM
+func part2(layout Layout) int {
 	bruteForce := 0
 	for x := 0; x < layout.N; x++ {
 		for y := 0; y < layout.N; y++ {
@@ -113,7 +98,16 @@ func main() {
 			}
 		}
 	}
+	return bruteForce
+}
 
-	// 51
-	fmt.Println("Part 2:", bruteForce)
+func init() {
+	aoc.Register(aoc.Puzzle[Layout, int, int]{
+		Year:  2023,
+		Day:   16,
+		Title: "The Floor Will Be Lava",
+		Parse: aoc.Lines(parseLayout),
+		Part1: part1,
+		Part2: part2,
+	})
 }
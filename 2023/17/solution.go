@@ -0,0 +1,106 @@
+// https://adventofcode.com/2023/day/17
+
+package day17
+
+import (
+	"math"
+
+	"github.com/aurelbec/advent-of-code/aoc"
+	"github.com/aurelbec/advent-of-code/utils"
+	"github.com/aurelbec/advent-of-code/utils/collections"
+)
+
+const (
+	U = iota
+	R
+	D
+	L
+)
+
+var offsets = map[int][2]int{
+	U: {0, -1},
+	R: {+1, 0},
+	D: {0, +1},
+	L: {-1, 0},
+}
+
+type node struct {
+	loc utils.Location2D[int]
+	dir int
+}
+
+type City struct {
+	loss utils.Grid[int]
+}
+
+// getMinimalHeatLoss returns the lowest heat loss from start to end with Dijkstra algorithm
+// it stops as soon as end is popped, as its heat loss can no longer decrease
+func (c City) getMinimalHeatLoss(start, end utils.Location2D[int], minStreak, maxStreak int) int {
+	settled := make(map[node]bool, c.loss.Width*c.loss.Height*4)
+	queue := collections.NewPriorityQueue[node, int]()
+	for dir := range offsets {
+		queue.Push(node{loc: start, dir: dir}, 0)
+	}
+
+	for !queue.IsEmpty() {
+		current, currentHeatLoss, _ := queue.Pop()
+		if current.loc == end {
+			return currentHeatLoss
+		}
+		settled[current] = true
+
+		for dir, offset := range offsets {
+			// ignore reverse and forward, forward steps being explored directly
+			if (current.dir+2)%4 == dir || current.dir == dir {
+				continue
+			}
+
+			// explore all forward steps directly in direction
+			nextHeatLoss := currentHeatLoss
+			for i := 1; i <= maxStreak; i++ {
+				next := node{loc: current.loc.MovedBy(i*offset[0], i*offset[1]), dir: dir}
+				if !c.loss.InBounds(next.loc) {
+					break
+				}
+
+				nextHeatLoss += c.loss.At(next.loc)
+				if i >= minStreak && !settled[next] {
+					queue.Decrease(next, nextHeatLoss)
+				}
+			}
+		}
+	}
+
+	return math.MaxInt
+}
+
+func parseCity(inputs []string) City {
+	return City{loss: utils.ParseGrid(inputs, func(b byte) int { return int(b - '0') })}
+}
+
+func part1(city City) int {
+	return city.getMinimalHeatLoss(
+		utils.NewLocation2D(0, 0),
+		utils.NewLocation2D(city.loss.Width-1, city.loss.Height-1),
+		1, 3,
+	)
+}
+
+func part2(city City) int {
+	return city.getMinimalHeatLoss(
+		utils.NewLocation2D(0, 0),
+		utils.NewLocation2D(city.loss.Width-1, city.loss.Height-1),
+		4, 10,
+	)
+}
+
+func init() {
+	aoc.Register(aoc.Puzzle[City, int, int]{
+		Year:  2023,
+		Day:   17,
+		Title: "Clumsy Crucible",
+		Parse: aoc.Lines(parseCity),
+		Part1: part1,
+		Part2: part2,
+	})
+}
@@ -1,12 +1,12 @@
 // https://adventofcode.com/2023/day/18
 
-package main
+package day18
 
 import (
 	"fmt"
 	"strconv"
-	"time"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 )
 
@@ -55,22 +55,27 @@ func parseInstructions(inputs []string) (Instructions, Instructions) {
 	return instructions, correctedInstructions
 }
 
-func main() {
-	fmt.Println("--- 2023 Day 18: Lavaduct Lagoon ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
+// parseDigPlans returns both the instructions as read, and the ones corrected using the colors
+func parseDigPlans(inputs []string) [2]Instructions {
 	instructions, correctedInstructions := parseInstructions(inputs)
+	return [2]Instructions{instructions, correctedInstructions}
+}
 
-	////////////////////////////////////////
-
-	// 62
-	fmt.Println("Part 1:", instructions.getLagoonArea())
+func part1(plans [2]Instructions) int {
+	return plans[0].getLagoonArea()
+}
 
-	////////////////////////////////////////
+func part2(plans [2]Instructions) int {
+	return plans[1].getLagoonArea()
+}
 
-	// 952408144115
-	fmt.Println("Part 2:", correctedInstructions.getLagoonArea())
+func init() {
+	aoc.Register(aoc.Puzzle[[2]Instructions, int, int]{
+		Year:  2023,
+		Day:   18,
+		Title: "Lavaduct Lagoon",
+		Parse: aoc.Lines(parseDigPlans),
+		Part1: part1,
+		Part2: part2,
+	})
 }
@@ -0,0 +1,188 @@
+// https://adventofcode.com/2023/day/19
+
+package day19
+
+import (
+	"maps"
+
+	"github.com/aurelbec/advent-of-code/aoc"
+	"github.com/aurelbec/advent-of-code/utils"
+)
+
+const (
+	X = 'x'
+	M = 'm'
+	A = 'a'
+	S = 's'
+)
+
+var getter = map[byte]func(Item) int{
+	X: func(i Item) int { return i.x },
+	M: func(i Item) int { return i.m },
+	A: func(i Item) int { return i.a },
+	S: func(i Item) int { return i.s },
+}
+
+var comparator = map[byte]func(int, int) bool{
+	'<': func(a, b int) bool { return a < b },
+	'>': func(a, b int) bool { return a > b },
+}
+
+type Item struct {
+	x, m, a, s int
+}
+
+func (i Item) rating() int {
+	return i.x + i.m + i.a + i.s
+}
+
+type ItemRanges map[byte]utils.Interval[int]
+
+func NewItemRanges(min, max int) ItemRanges {
+	return ItemRanges{
+		X: utils.NewInterval(min, max),
+		M: utils.NewInterval(min, max),
+		A: utils.NewInterval(min, max),
+		S: utils.NewInterval(min, max),
+	}
+}
+
+func (ir ItemRanges) count() int {
+	return ir[X].Len() * ir[M].Len() * ir[A].Len() * ir[S].Len()
+}
+
+type Workflows map[string]Workflow
+
+type Workflow struct {
+	rules []Rule
+}
+
+type Rule struct {
+	field    byte
+	operator byte
+	value    int
+	next     string
+}
+
+func (r Rule) eval(item Item) bool {
+	return r.operator == 0 || comparator[r.operator](getter[r.field](item), r.value)
+}
+
+func (ws Workflows) getItemsRating(start string, items []Item) []int {
+	return utils.ArrayMap(items, func(item Item) int { return ws.getItemRating(start, item) })
+}
+
+func (ws Workflows) getItemRating(start string, item Item) int {
+	for _, rule := range ws[start].rules {
+		if !rule.eval(item) {
+			continue
+		}
+		switch rule.next {
+		case "A":
+			return item.rating()
+		case "R":
+			return 0
+		default:
+			return ws.getItemRating(rule.next, item)
+		}
+	}
+	return 0
+}
+
+func (ws Workflows) getAcceptedItemsCount(start string, current ItemRanges) int {
+	if start == "A" {
+		return current.count()
+	} else if start == "R" {
+		return 0
+	}
+
+	count := 0
+	for _, rule := range ws[start].rules {
+		next := maps.Clone(current)
+		if rule.operator == '>' {
+			current[rule.field], next[rule.field] = current[rule.field].Split(rule.value, true)
+		} else if rule.operator == '<' {
+			next[rule.field], current[rule.field] = current[rule.field].Split(rule.value, false)
+		}
+		count += ws.getAcceptedItemsCount(rule.next, next)
+	}
+	return count
+}
+
+type namedWorkflow struct {
+	name     string
+	workflow Workflow
+}
+
+// workflowParser parses a named workflow such as px{a<2006:qkq,m>2090:A,rhg}
+var workflowParser = func() utils.Parser[namedWorkflow] {
+	rule := utils.Or(
+		utils.Seq4(utils.OneOf("xmas"), utils.OneOf("<>"), utils.Int(), utils.Prefixed(":", utils.Identifier()),
+			func(field, operator byte, value int, next string) Rule {
+				return Rule{field: field, operator: operator, value: value, next: next}
+			}),
+		utils.Map(utils.Identifier(), func(next string) Rule { return Rule{next: next} }),
+	)
+	return utils.Seq2(utils.Identifier(), utils.Between("{", utils.SeparatedBy(rule, ","), "}"),
+		func(name string, rules []Rule) namedWorkflow {
+			return namedWorkflow{name: name, workflow: Workflow{rules: rules}}
+		})
+}()
+
+type System struct {
+	workflows Workflows
+	items     []Item
+}
+
+// parseWorkflows returns the workflows of the section, by name
+func parseWorkflows(section utils.Section) (Workflows, error) {
+	workflows := make(Workflows, len(section.Lines))
+	for i, input := range section.Lines {
+		named, err := workflowParser.Parse(input)
+		if err != nil {
+			return workflows, section.Errorf(i, "invalid workflow: %w", err)
+		}
+		workflows[named.name] = named.workflow
+	}
+	return workflows, nil
+}
+
+// parseItems returns the items of the section
+func parseItems(section utils.Section) ([]Item, error) {
+	items := make([]Item, 0, len(section.Lines))
+	for i, input := range section.Lines {
+		var item Item
+		if err := utils.Scan(input, "{{x={x},m={m},a={a},s={s}}", &item.x, &item.m, &item.a, &item.s); err != nil {
+			return items, section.Errorf(i, "invalid item: %w", err)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func parseSystem(inputs []string) System {
+	sections := utils.Must(utils.SplitSections(inputs, "workflows", "items"))
+	return System{
+		workflows: utils.Must(parseWorkflows(sections.Get("workflows"))),
+		items:     utils.Must(parseItems(sections.Get("items"))),
+	}
+}
+
+func part1(system System) int {
+	return utils.Sum(system.workflows.getItemsRating("in", system.items))
+}
+
+func part2(system System) int {
+	return system.workflows.getAcceptedItemsCount("in", NewItemRanges(1, 4000))
+}
+
+func init() {
+	aoc.Register(aoc.Puzzle[System, int, int]{
+		Year:  2023,
+		Day:   19,
+		Title: "Aplenty",
+		Parse: aoc.Lines(parseSystem),
+		Part1: part1,
+		Part2: part2,
+	})
+}
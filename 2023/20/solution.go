@@ -1,13 +1,12 @@
 // https://adventofcode.com/2023/day/20
 
-package main
+package day20
 
 import (
 	"fmt"
 	"math"
-	"strings"
-	"time"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 	"github.com/aurelbec/advent-of-code/utils/collections"
 )
@@ -133,7 +132,7 @@ func (b *Button) getSignalsCountAfter(pushes int) int {
 }
 
 func (b *Button) getCountUntilConjunctionOn(target *Module) int {
-	for pow := int(math.Log2(float64(b.pushes))); pow < 14; pow++ {
+	for pow := int(math.Log2(float64(max(b.pushes, 1)))); pow < 14; pow++ {
 		b.getSignalsCountAfter((2 << pow) - b.pushes)
 		if count, valid := target.getConjunctionValue(""); valid {
 			return count
@@ -146,23 +145,23 @@ func parseModules(inputs []string) map[string]*Module {
 	modules := make(map[string]*Module, len(inputs))
 	outputs := make(map[string][]string, len(inputs))
 
-	for _, input := range inputs {
+	for i, input := range inputs {
 		module := &Module{
 			state:  low,
 			inputs: make(map[*Module]Pulse),
 		}
 
-		left, right, _ := strings.Cut(input, " -> ")
-		switch kind := left[0]; kind {
-		case '%', '&':
-			module.name = left[1:]
-			module.kind = kind
-		default:
-			module.name = left
+		var kind string
+		var next []string
+		if err := utils.Scan(input, "{kind:enum(%|&|)}{name} -> {outputs:list}", &kind, &module.name, &next); err != nil {
+			panic(fmt.Errorf("line %d: %w", i+1, err))
+		}
+		if kind != "" {
+			module.kind = kind[0]
 		}
 
 		modules[module.name] = module
-		outputs[module.name] = strings.Split(right, ", ")
+		outputs[module.name] = next
 	}
 
 	for name, module := range modules {
@@ -181,26 +180,23 @@ func parseModules(inputs []string) map[string]*Module {
 	return modules
 }
 
-func main() {
-	fmt.Println("--- 2023 Day 20: Pulse Propagation ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	modules := parseModules(inputs)
-
-	rx := modules["rx"]
-	broadcaster := modules["broadcaster"]
-	button := Button{broadcaster: broadcaster}
-
-	////////////////////////////////////////
-
-	// 32000000
-	fmt.Println("Part 1:", button.getSignalsCountAfter(1000))
+func part1(modules map[string]*Module) int {
+	button := Button{broadcaster: modules["broadcaster"]}
+	return button.getSignalsCountAfter(1000)
+}
 
-	////////////////////////////////////////
+func part2(modules map[string]*Module) int {
+	button := Button{broadcaster: modules["broadcaster"]}
+	return button.getCountUntilConjunctionOn(modules["rx"])
+}
 
-	// undefined
-	fmt.Println("Part 2:", button.getCountUntilConjunctionOn(rx))
+func init() {
+	aoc.Register(aoc.Puzzle[map[string]*Module, int, int]{
+		Year:  2023,
+		Day:   20,
+		Title: "Pulse Propagation",
+		Parse: aoc.Lines(parseModules),
+		Part1: part1,
+		Part2: part2,
+	})
 }
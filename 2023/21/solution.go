@@ -0,0 +1,108 @@
+// https://adventofcode.com/2023/day/21
+
+package day21
+
+import (
+	"github.com/aurelbec/advent-of-code/aoc"
+	"github.com/aurelbec/advent-of-code/utils"
+)
+
+const (
+	gardenPlot = '.'
+	rock       = '#'
+	start      = 'S'
+)
+
+type Garden struct {
+	grid  utils.Grid[byte]
+	start utils.Location2D[int]
+}
+
+// isTileValid tells whether the tile is a garden plot, the grid being infinitely repeated if infinite
+func (g Garden) isTileValid(tile utils.Location2D[int], infinite bool) bool {
+	if !g.grid.InBounds(tile) && !infinite {
+		return false
+	}
+	return g.grid.AtWrapped(tile) != rock
+}
+
+func (g Garden) getReachableTiles(steps int, infinite bool) int {
+	// express steps as steps=n*P+R
+	p := g.grid.Height
+	r := steps % p
+
+	points := []utils.Location2D[int]{}
+	reached := utils.NewSet(g.start)
+	counts := []int{1} // number of tiles reached after each step, only the last tiles being kept
+
+	for s := 0; s <= steps; s++ {
+		next := utils.NewSet[utils.Location2D[int]]()
+		for tile := range reached {
+			for _, dir := range utils.Directions4 {
+				if neighbour := tile.MovedBy(dir.X, dir.Y); g.isTileValid(neighbour, infinite) {
+					next.Add(neighbour)
+				}
+			}
+		}
+		reached = next
+		counts = append(counts, reached.Len())
+
+		// find the first 3 interpolation points if grid is infinite
+		if s%p == r && infinite {
+			points = append(points, utils.NewLocation2D(s, counts[s]))
+			if l := len(points); l > 3 {
+				x0, x1, x2, y0, y1, y2 := points[l-3].X, points[l-2].X, points[l-1].X, points[l-3].Y, points[l-2].Y, points[l-1].Y
+				// ensure interpolation is valid by looking to previous value
+				prev := utils.Interpolation(steps, points[l-4].X, x0, x1, points[l-4].Y, y0, y1)
+				current := utils.Interpolation(steps, x0, x1, x2, y0, y1, y2)
+				if prev == current {
+					return current
+				}
+			}
+		}
+
+		// stop after 2 periods iterations if grid is not infinite
+		if s > 2*(p-1) && !infinite {
+			return counts[2*(p-1)-steps%2]
+		}
+	}
+
+	return counts[steps]
+}
+
+// parseGarden returns the garden grid along with the starting position
+func parseGarden(inputs []string) Garden {
+	garden := Garden{grid: utils.ParseByteGrid(inputs)}
+	garden.start, _ = garden.grid.Find(func(tile byte) bool { return tile == start })
+	garden.grid.Set(garden.start, gardenPlot)
+	return garden
+}
+
+// 6: 16
+// 64: 42
+func part1(garden Garden) int {
+	return garden.getReachableTiles(64, false)
+}
+
+// 6: 16
+// 10: 50
+// 50: 1594
+// 100: 6536
+// 500: 167004
+// 1000: 668697
+// 5000: 16733044
+// 26501365: 470149643712804
+func part2(garden Garden) int {
+	return garden.getReachableTiles(26501365, true)
+}
+
+func init() {
+	aoc.Register(aoc.Puzzle[Garden, int, int]{
+		Year:  2023,
+		Day:   21,
+		Title: "Step Counter",
+		Parse: aoc.Lines(parseGarden),
+		Part1: part1,
+		Part2: part2,
+	})
+}
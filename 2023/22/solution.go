@@ -1,11 +1,9 @@
 // https://adventofcode.com/2023/day/22
 
-package main
+package day22
 
 import (
-	"fmt"
-	"time"
-
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 	"github.com/aurelbec/advent-of-code/utils/collections"
 )
@@ -50,13 +48,19 @@ func (b *Brick) getDependentBricks() int {
 	return b.inCascade
 }
 
+// parseBrick returns the brick whose ends are described by the input
+func parseBrick(input string) (*Brick, error) {
+	brick := &Brick{inCascade: -1}
+	err := utils.Scan(input, "{x1},{y1},{z1}~{x2},{y2},{z2}",
+		&brick.start.X, &brick.start.Y, &brick.start.Z, &brick.end.X, &brick.end.Y, &brick.end.Z)
+	return brick, err
+}
+
 func parseBricks(inputs []string) []*Brick {
 	X, Y, Z := 0, 0, 0
-	bricks := make([]*Brick, len(inputs))
-	for i, input := range inputs {
-		brick := Brick{id: i + 1, inCascade: -1}
-		fmt.Sscanf(input, "%v,%v,%v~%v,%v,%v", &brick.start.X, &brick.start.Y, &brick.start.Z, &brick.end.X, &brick.end.Y, &brick.end.Z)
-		bricks[i] = &brick
+	bricks := utils.Must(utils.MapLines(inputs, parseBrick))
+	for i, brick := range bricks {
+		brick.id = i + 1
 		X = max(X, brick.start.X, brick.end.X)
 		Y = max(Y, brick.start.Y, brick.end.Y)
 		Z = max(Z, brick.start.Z, brick.end.Z)
@@ -140,29 +144,28 @@ func parseBricks(inputs []string) []*Brick {
 	return bricks
 }
 
-func main() {
-	fmt.Println("--- 2023 Day 22: Sand Slabs ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	bricks := parseBricks(inputs)
-
-	////////////////////////////////////////
-
-	// 5
-	fmt.Println("Part 1:", utils.SumFunc(bricks, func(b *Brick, _ ...int) int {
+func part1(bricks []*Brick) int {
+	return utils.SumFunc(bricks, func(b *Brick, _ ...int) int {
 		if b.isSafeToDisintegrated() {
 			return 1
 		}
 		return 0
-	}))
-
-	////////////////////////////////////////
+	})
+}
 
-	// 7
-	fmt.Println("Part 2:", utils.SumFunc(bricks, func(b *Brick, _ ...int) int {
+func part2(bricks []*Brick) int {
+	return utils.SumFunc(bricks, func(b *Brick, _ ...int) int {
 		return b.getDependentBricks()
-	}))
+	})
+}
+
+func init() {
+	aoc.Register(aoc.Puzzle[[]*Brick, int, int]{
+		Year:  2023,
+		Day:   22,
+		Title: "Sand Slabs",
+		Parse: aoc.Lines(parseBricks),
+		Part1: part1,
+		Part2: part2,
+	})
 }
@@ -1,12 +1,11 @@
 // https://adventofcode.com/2023/day/23
 
-package main
+package day23
 
 import (
 	"fmt"
-	"maps"
-	"time"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 	"github.com/aurelbec/advent-of-code/utils/collections"
 )
@@ -51,27 +50,36 @@ func isCrossing(neighbors ...byte) bool {
 }
 
 func getLongestDist(edges map[string]map[string]int, start string, end string) int {
-	if dist, found := getLongestDistRec(edges, start, end, map[string]struct{}{start: {}}); found {
+	// number the nodes, to mark them as visited in a bitset
+	ids := map[string]int{start: 0}
+	for node := range edges {
+		if _, found := ids[node]; !found {
+			ids[node] = len(ids)
+		}
+	}
+	if len(ids) > utils.FixedBitsetSize {
+		panic(fmt.Sprintf("%d crossings, at most %d are supported", len(ids), utils.FixedBitsetSize))
+	}
+
+	if dist, found := getLongestDistRec(edges, ids, start, end, utils.NewFixedBitset(ids[start])); found {
 		return dist
 	} else {
 		return -1
 	}
 }
 
-func getLongestDistRec(edges map[string]map[string]int, start string, end string, visited map[string]struct{}) (int, bool) {
+func getLongestDistRec(edges map[string]map[string]int, ids map[string]int, start string, end string, visited utils.FixedBitset) (int, bool) {
 	maxDist, found := 0, false
 	for next, cost := range edges[start] {
 		if next == end {
 			return cost, true
 		}
 
-		if _, visited := visited[next]; visited {
+		if visited.Test(ids[next]) {
 			continue
 		}
-		visited := maps.Clone(visited)
-		visited[next] = struct{}{}
 
-		dist, ok := getLongestDistRec(edges, next, end, visited)
+		dist, ok := getLongestDistRec(edges, ids, next, end, visited.With(ids[next]))
 		found = found || ok
 		maxDist = max(maxDist, dist+cost)
 	}
@@ -101,7 +109,9 @@ func parsePaths(inputs []string) map[string]map[string]int {
 		}
 	}
 
-	visited := make(map[[2]int]bool)
+	// tiles visited, by index y*width+x
+	width := len(inputs[0])
+	visited := utils.Bitset{}
 	for !queue.IsEmpty() {
 		n, _ := queue.Pop()
 		x, y := n.loc[0], n.loc[1]
@@ -134,10 +144,10 @@ func parsePaths(inputs []string) map[string]map[string]int {
 		}
 
 		// do not visit twice
-		if visited[n.loc] {
+		if visited.Test(y*width + x) {
 			continue
 		}
-		visited[n.loc] = true
+		visited.Set(y*width + x)
 
 		for slope := range dirs {
 			queue.Push(n.next(slope))
@@ -147,22 +157,11 @@ func parsePaths(inputs []string) map[string]map[string]int {
 	return edges
 }
 
-func main() {
-	fmt.Println("--- 2023 Day 23: A Long Walk ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	edges := parsePaths(inputs)
-
-	////////////////////////////////////////
-
-	// 94
-	fmt.Println("Part 1:", getLongestDist(edges, "S", "E"))
-
-	////////////////////////////////////////
+func part1(edges map[string]map[string]int) int {
+	return getLongestDist(edges, "S", "E")
+}
 
+func part2(edges map[string]map[string]int) int {
 	// add edges in both direction
 	for from, next := range edges {
 		for to, dist := range next {
@@ -174,6 +173,16 @@ func main() {
 		}
 	}
 
-	// 154
-	fmt.Println("Part 2:", getLongestDist(edges, "S", "E"))
+	return getLongestDist(edges, "S", "E")
+}
+
+func init() {
+	aoc.Register(aoc.Puzzle[map[string]map[string]int, int, int]{
+		Year:  2023,
+		Day:   23,
+		Title: "A Long Walk",
+		Parse: aoc.Lines(parsePaths),
+		Part1: part1,
+		Part2: part2,
+	})
 }
@@ -1,11 +1,9 @@
 // https://adventofcode.com/2023/day/24
 
-package main
+package day24
 
 import (
-	"fmt"
-	"time"
-
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 )
 
@@ -98,39 +96,51 @@ func (objects Objects) getIntersectionsCount(min, max int) int {
 	return count
 }
 
-func parseHailStones(inputs []string) Objects {
-	hailStones := make(Objects, len(inputs))
-	for i, input := range inputs {
-		fmt.Sscanf(input, "%v, %v, %v @ %v, %v, %v",
-			&hailStones[i].x,
-			&hailStones[i].y,
-			&hailStones[i].z,
-			&hailStones[i].vx,
-			&hailStones[i].vy,
-			&hailStones[i].vz,
-		)
-	}
-	return hailStones
+// parseHailStone returns the position and the velocity of the hail stone
+// the values are aligned in columns, so they may be preceded by an extra space
+func parseHailStone(input string) (Object, error) {
+	hailStone := Object{}
+	err := utils.Scan(input, "{x},[  | ]{y},[  | ]{z} @[  | ]{vx},[  | ]{vy},[  | ]{vz}",
+		&hailStone.x, &hailStone.y, &hailStone.z, &hailStone.vx, &hailStone.vy, &hailStone.vz)
+	return hailStone, err
 }
 
-func main() {
-	fmt.Println("--- 2023 Day 24: Never Tell Me The Odds ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	hailStones := parseHailStones(inputs)
+func parseHailStones(inputs []string) Objects {
+	return utils.Must(utils.MapLines(inputs, parseHailStone))
+}
 
-	////////////////////////////////////////
+type Storm struct {
+	hailStones Objects
+	testArea   utils.Interval[int]
+}
 
-	// 2
-	fmt.Println("Part 1:", hailStones.getIntersectionsCount(7, 27))
+// parseStorm returns the hail stones, along with the test area which is smaller for the example
+func parseStorm(input aoc.Input) Storm {
+	storm := Storm{hailStones: parseHailStones(input.Lines)}
+	if input.IsExample() {
+		storm.testArea = utils.NewInterval(7, 27)
+	} else {
+		storm.testArea = utils.NewInterval(200000000000000, 400000000000000)
+	}
+	return storm
+}
 
-	////////////////////////////////////////
+func part1(storm Storm) int {
+	return storm.hailStones.getIntersectionsCount(storm.testArea.Min, storm.testArea.Max)
+}
 
-	rock := hailStones.getCollidingRock()
+func part2(storm Storm) int {
+	rock := storm.hailStones.getCollidingRock()
+	return rock.x + rock.y + rock.z
+}
 
-	// 47
-	fmt.Println("Part 2:", rock.x+rock.y+rock.z)
+func init() {
+	aoc.Register(aoc.Puzzle[Storm, int, int]{
+		Year:  2023,
+		Day:   24,
+		Title: "Never Tell Me The Odds",
+		Parse: parseStorm,
+		Part1: part1,
+		Part2: part2,
+	})
 }
@@ -1,19 +1,17 @@
 // https://adventofcode.com/2023/day/25
 
-package main
+package day25
 
 import (
-	"fmt"
 	"strings"
-	"time"
 
+	"github.com/aurelbec/advent-of-code/aoc"
 	"github.com/aurelbec/advent-of-code/utils"
 	"github.com/aurelbec/advent-of-code/utils/collections"
 )
 
 type Node struct {
 	neighbors map[*Node]*Edge
-	group     int
 }
 
 type Edge struct {
@@ -21,8 +19,8 @@ type Edge struct {
 }
 
 type Graph struct {
-	nodes map[*Node]struct{}
-	edges map[*Edge]struct{}
+	nodes utils.Set[*Node]
+	edges utils.Set[*Edge]
 }
 
 func (g *Graph) bfs(source, dest *Node) bool {
@@ -32,15 +30,10 @@ func (g *Graph) bfs(source, dest *Node) bool {
 	for !queue.IsEmpty() {
 		current, _ := queue.Dequeue()
 
-		// tag group if BFS is executed blindly
-		if dest == nil && visited[current] != nil {
-			current.group = visited[current].group
-		}
-
 		// reconstruct path if dest is found, forbidden edges for next step
 		if current == dest {
 			for prev := visited[current]; prev != nil; current, prev = prev, visited[prev] {
-				g.edges[current.neighbors[prev]] = struct{}{}
+				g.edges.Add(current.neighbors[prev])
 			}
 			return true
 		}
@@ -49,7 +42,7 @@ func (g *Graph) bfs(source, dest *Node) bool {
 		for next, edge := range current.neighbors {
 			if _, visited := visited[next]; visited {
 				continue
-			} else if _, visited := g.edges[edge]; visited {
+			} else if g.edges.Has(edge) {
 				continue
 			}
 
@@ -62,7 +55,7 @@ func (g *Graph) bfs(source, dest *Node) bool {
 }
 
 func (g *Graph) cutPaths(source, dest *Node, n int) bool {
-	g.edges = make(map[*Edge]struct{})
+	g.edges = utils.NewSet[*Edge]()
 
 	for i := 0; i <= n; i++ {
 		if !g.bfs(source, dest) {
@@ -73,40 +66,47 @@ func (g *Graph) cutPaths(source, dest *Node, n int) bool {
 	return true
 }
 
+// split returns the sizes of both parts of the graph, once the given number of edges are cut
+// nodes joined by more than cuts edge-disjoint paths are on the same side
 func (g *Graph) split(cuts int) (int, int) {
-	g.edges = make(map[*Edge]struct{})
+	g.edges = utils.NewSet[*Edge]()
 
-	var source *Node
+	var source, other *Node
 	for node := range g.nodes {
 		if len(node.neighbors) > cuts {
 			source = node
-			source.group = 1
 			break
 		}
 	}
 
+	sides := collections.NewDisjointSet(g.nodes.Slice()...)
 	for dest := range g.nodes {
-		if dest.group > 0 {
+		if sides.Connected(source, dest) || (other != nil && sides.Connected(other, dest)) {
 			continue
 		}
 
-		if !g.cutPaths(source, dest, cuts) {
-			// use disconnected graph to categorize as many nodes as possible
-			g.bfs(source, nil)
-			dest.group = 2
-			g.bfs(dest, nil)
+		if g.cutPaths(source, dest, cuts) {
+			sides.Union(source, dest)
+			continue
 		}
-	}
 
-	lhs, rhs := 0, 0
-	for node := range g.nodes {
-		if node.group == 1 {
-			lhs++
-		} else {
-			rhs++
+		// dest is on the other side, and the paths found cross the cut
+		// so connect as many nodes as possible through the edges not used by the paths
+		for node := range g.nodes {
+			for next, edge := range node.neighbors {
+				if !g.edges.Has(edge) {
+					sides.Union(node, next)
+				}
+			}
 		}
+		if other == nil {
+			other = dest
+		}
+		sides.Union(other, dest)
 	}
-	return lhs, rhs
+
+	lhs := sides.Size(source)
+	return lhs, sides.Len() - lhs
 }
 
 func parseGraph(inputs []string) *Graph {
@@ -129,31 +129,20 @@ func parseGraph(inputs []string) *Graph {
 		}
 	}
 
-	graph := Graph{nodes: make(map[*Node]struct{}, len(nodes))}
-	for _, node := range nodes {
-		graph.nodes[node] = struct{}{}
-	}
-	return &graph
+	return &Graph{nodes: utils.NewSet(utils.MapValues(nodes)...)}
 }
 
-func main() {
-	fmt.Println("--- 2023 Day 25: Snowverload ---")
-	defer func(start time.Time) { fmt.Println("Total time:", time.Since(start).Round(time.Microsecond)) }(time.Now())
-
-	// init
-	inputs := utils.MustReadInput("example.txt")
-
-	graph := parseGraph(inputs)
-
-	////////////////////////////////////////
-
+func part1(graph *Graph) int {
 	lhs, rhs := graph.split(3)
+	return lhs * rhs
+}
 
-	// 54
-	fmt.Println("Part 1:", lhs*rhs)
-
-	////////////////////////////////////////
-
-	// 0
-	fmt.Println("Part 2:", 0)
+func init() {
+	aoc.Register(aoc.Puzzle[*Graph, int, int]{
+		Year:  2023,
+		Day:   25,
+		Title: "Snowverload",
+		Parse: aoc.Lines(parseGraph),
+		Part1: part1,
+	})
 }
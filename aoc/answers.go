@@ -0,0 +1,133 @@
+package aoc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// AnswersFile is the name of the file holding the expected answers, in each day directory
+const AnswersFile = "answers.json"
+
+// Status is the verification status of an answer
+type Status int
+
+const (
+	Missing       Status = iota // no expected answer is known
+	Pass                        // the answer is the expected one
+	Fail                        // the answer differs from the expected one
+	NotApplicable               // there is nothing to answer for this part and input
+)
+
+// String returns the status name
+func (status Status) String() string {
+	switch status {
+	case Pass:
+		return "pass"
+	case Fail:
+		return "fail"
+	case NotApplicable:
+		return "n/a"
+	default:
+		return "missing"
+	}
+}
+
+// Answers holds the expected answers of a puzzle, by input name (example, input...) then by part (part1, part2)
+// a null answer means that the part is not applicable for this input (e.g. the example has no solution)
+//
+//	{
+//		"example": { "part1": "32000000", "part2": null },
+//		"input": { "part1": "...", "part2": "..." }
+//	}
+type Answers map[string]map[string]*string
+
+// ReadAnswers reads the expected answers from the answers file of the directory
+// a missing file is not an error, every answer is then missing
+func ReadAnswers(dir string) (Answers, error) {
+	data, err := os.ReadFile(filepath.Join(dir, AnswersFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return Answers{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	answers := Answers{}
+	if err := json.Unmarshal(data, &answers); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", filepath.Join(dir, AnswersFile), err)
+	}
+	return answers, nil
+}
+
+// recorded returns the answer recorded for the part and the input, nil if it is recorded as null
+func (answers Answers) recorded(input string, part int) (answer *string, found bool) {
+	answer, found = answers[input][fmt.Sprintf("part%d", part)]
+	return answer, found
+}
+
+// Expected returns the expected answer of the part for the input, and false if there is none:
+// when nothing is recorded, or when the answer is recorded as null
+func (answers Answers) Expected(input string, part int) (string, bool) {
+	if answer, _ := answers.recorded(input, part); answer != nil {
+		return *answer, true
+	}
+	return "", false
+}
+
+// Applicable tells whether the part has an answer for the input, that is unless its answer is recorded as null
+func (answers Answers) Applicable(input string, part int) bool {
+	answer, found := answers.recorded(input, part)
+	return !found || answer != nil
+}
+
+// Check compares the answer of the part for the input to the expected one
+func (answers Answers) Check(input string, part int, answer string) (Status, string) {
+	expected, found := answers.Expected(input, part)
+	switch {
+	case !answers.Applicable(input, part):
+		return NotApplicable, ""
+	case !found:
+		return Missing, ""
+	case answer != expected:
+		return Fail, expected
+	}
+	return Pass, expected
+}
+
+// Diff returns a line by line comparison of the expected and actual answers, only showing the lines differing
+func Diff(expected, actual string) string {
+	expectedLines := strings.Split(expected, "\n")
+	actualLines := strings.Split(actual, "\n")
+
+	diff := strings.Builder{}
+	for i := 0; i < max(len(expectedLines), len(actualLines)); i++ {
+		e, a := "", ""
+		if i < len(expectedLines) {
+			e = expectedLines[i]
+		}
+		if i < len(actualLines) {
+			a = actualLines[i]
+		}
+		if e == a {
+			continue
+		}
+		if len(expectedLines) > 1 || len(actualLines) > 1 {
+			fmt.Fprintf(&diff, "  line %d:\n", i+1)
+		}
+		fmt.Fprintf(&diff, "  - expected: %s\n  + actual:   %s\n", e, a)
+	}
+	return diff.String()
+}
+
+// WriteAnswers saves the expected answers into the answers file of the directory
+func WriteAnswers(dir string, answers Answers) error {
+	data, err := json.MarshalIndent(answers, "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, AnswersFile), append(data, '\n'), 0o644)
+}
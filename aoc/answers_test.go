@@ -0,0 +1,48 @@
+package aoc
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAnswersCheck(t *testing.T) {
+	answers := Answers{}
+	if err := json.Unmarshal([]byte(`{"example": {"part1": "42", "part2": null}}`), &answers); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input      string
+		part       int
+		answer     string
+		status     Status
+		expected   string
+		applicable bool
+	}{
+		{"example", 1, "42", Pass, "42", true},
+		{"example", 1, "41", Fail, "42", true},
+		{"example", 2, "0", NotApplicable, "", false},
+		{"input", 1, "42", Missing, "", true},
+	}
+	for _, test := range tests {
+		status, expected := answers.Check(test.input, test.part, test.answer)
+		if status != test.status || expected != test.expected {
+			t.Errorf("Check(%s, %d, %s) = %v, %q, want %v, %q", test.input, test.part, test.answer, status, expected, test.status, test.expected)
+		}
+		if applicable := answers.Applicable(test.input, test.part); applicable != test.applicable {
+			t.Errorf("Applicable(%s, %d) = %v", test.input, test.part, applicable)
+		}
+		if expected, found := answers.Expected(test.input, test.part); found != (test.expected != "") || expected != test.expected {
+			t.Errorf("Expected(%s, %d) = %q, %v", test.input, test.part, expected, found)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	if diff := Diff("42", "41"); diff != "  - expected: 42\n  + actual:   41\n" {
+		t.Errorf("Diff() of single lines = %q", diff)
+	}
+	if diff := Diff("a\nb\nc", "a\nx"); diff != "  line 2:\n  - expected: b\n  + actual:   x\n  line 3:\n  - expected: c\n  + actual:   \n" {
+		t.Errorf("Diff() of multiple lines = %q", diff)
+	}
+}
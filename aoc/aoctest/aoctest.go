@@ -0,0 +1,116 @@
+// Package aoctest checks and benchmarks a day from go test, on the cases generated from the answers file of its directory
+// the tests of a day run in its directory, from which the input files are read
+package aoctest
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/aurelbec/advent-of-code/aoc"
+	"github.com/aurelbec/advent-of-code/utils"
+)
+
+// Case is the expected answer of a part on an input of the day, as recorded in its answers file
+type Case struct {
+	Input  string // input name, that is the file name without extension, e.g. example2
+	Part   int
+	Answer string
+}
+
+// Name returns the name of the subtest of the case
+func (test Case) Name() string {
+	return fmt.Sprintf("%s/part%d", test.Input, test.Part)
+}
+
+// errSkipped marks a case that cannot be checked
+var errSkipped = errors.New("skipped")
+
+// lookup returns the solver of the day, registered by the package under test
+func lookup(tb testing.TB, year, day int) aoc.Solver {
+	tb.Helper()
+	solvers, err := aoc.Select(aoc.ID{Year: year, Day: day}.String())
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return solvers[0]
+}
+
+// read returns the input of the case, read from the directory
+// a missing file, such as the personal input which is not shared, skips the case
+func read(dir string, test Case) (aoc.Input, error) {
+	lines, err := utils.ReadLines(filepath.Join(dir, test.Input+".txt"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return aoc.Input{}, fmt.Errorf("%w: no %s input", errSkipped, test.Input)
+	} else if err != nil {
+		return aoc.Input{}, err
+	}
+	return aoc.Input{Name: test.Input, Lines: lines}, nil
+}
+
+// check solves the part of the case on its input, read from the directory, and compares the answer to the expected one
+func check(solver aoc.Solver, dir string, test Case) error {
+	if test.Answer == "" {
+		return fmt.Errorf("%w: %s: missing answer", errSkipped, test.Input)
+	}
+	input, err := read(dir, test)
+	if err != nil {
+		return err
+	}
+	answer, ok := solver.Solve(test.Part, solver.Parse(input))
+	if !ok {
+		return fmt.Errorf("%s: the puzzle has no part %d, while an answer is expected", test.Input, test.Part)
+	}
+	if actual := fmt.Sprint(answer); actual != test.Answer {
+		return fmt.Errorf("%s: wrong answer\n%s", test.Input, aoc.Diff(test.Answer, actual))
+	}
+	return nil
+}
+
+// Test checks each case of the day in its own subtest, named after the input and the part
+func Test(t *testing.T, year, day int, cases []Case) {
+	solver := lookup(t, year, day)
+	for _, test := range cases {
+		t.Run(test.Name(), func(t *testing.T) {
+			if err := check(solver, ".", test); errors.Is(err, errSkipped) {
+				t.Skip(err)
+			} else if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// Benchmark measures the parsing of each input of the cases of the day, then the part of each case
+// every part iteration works on its own freshly parsed input, which is not part of the measure
+func Benchmark(b *testing.B, year, day int, cases []Case) {
+	solver := lookup(b, year, day)
+	parsed := make(map[string]bool)
+	for _, test := range cases {
+		input, err := read(".", test)
+		if err != nil {
+			continue
+		}
+
+		if !parsed[test.Input] {
+			parsed[test.Input] = true
+			b.Run(test.Input+"/parse", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					solver.Parse(input)
+				}
+			})
+		}
+		b.Run(test.Name(), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				parsed := solver.Parse(input)
+				b.StartTimer()
+				if _, ok := solver.Solve(test.Part, parsed); !ok {
+					b.Skipf("the puzzle has no part %d", test.Part)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package aoctest
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aurelbec/advent-of-code/aoc"
+)
+
+func init() {
+	// counts the lines of the input, without part 2
+	aoc.Register(aoc.Puzzle[[]string, int, int]{
+		Year:  2015,
+		Day:   1,
+		Title: "Test",
+		Parse: func(input aoc.Input) []string { return input.Lines },
+		Part1: func(lines []string) int { return len(lines) },
+	})
+}
+
+func TestCheck(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "example.txt"), []byte("1\n2\n3\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	solver := lookup(t, 2015, 1)
+
+	tests := []struct {
+		test    Case
+		skipped bool
+		err     string
+	}{
+		{Case{Input: "example", Part: 1, Answer: "3"}, false, ""},
+		{Case{Input: "example", Part: 1, Answer: ""}, true, "skipped: example: missing answer"},
+		{Case{Input: "input", Part: 1, Answer: "3"}, true, "skipped: no input input"},
+		{Case{Input: "example", Part: 1, Answer: "7"}, false, "example: wrong answer\n  - expected: 7\n  + actual:   3\n"},
+		{Case{Input: "example", Part: 2, Answer: "3"}, false, "example: the puzzle has no part 2, while an answer is expected"},
+	}
+	for _, test := range tests {
+		err := check(solver, dir, test.test)
+		if errors.Is(err, errSkipped) != test.skipped {
+			t.Errorf("%s: check() error = %v, skipped %v", test.test.Name(), err, test.skipped)
+		}
+		if test.err == "" && err != nil || test.err != "" && (err == nil || err.Error() != test.err) {
+			t.Errorf("%s: check() error = %v, want %q", test.test.Name(), err, test.err)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package aoc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"slices"
+	"time"
+
+	"github.com/aurelbec/advent-of-code/utils"
+)
+
+// Steps of a puzzle resolution that are benchmarked
+const (
+	StepParse = "parse"
+	StepPart1 = "part1"
+	StepPart2 = "part2"
+)
+
+// noiseFloor is the slowdown under which a step is never considered as a regression, whatever the ratio
+const noiseFloor = time.Microsecond
+
+// Stats summarizes the measures of a step over all iterations
+type Stats struct {
+	Input  string        `json:"input"`           // name of the input measured
+	Error  string        `json:"error,omitempty"` // error of the step, that could not be measured
+	Min    time.Duration `json:"min"`
+	Median time.Duration `json:"median"`
+	P95    time.Duration `json:"p95"`
+	Allocs uint64        `json:"allocs"` // allocations per iteration
+	Bytes  uint64        `json:"bytes"`  // bytes allocated per iteration
+}
+
+// Benchmark holds the measures of each step of a puzzle resolution
+type Benchmark struct {
+	ID         string           `json:"id"`
+	Iterations int              `json:"iterations"`
+	Steps      map[string]Stats `json:"steps"`
+}
+
+// measure runs n times the step on the value returned by setup, and returns the statistics of the step only
+func measure(n int, setup func() any, step func(any)) Stats {
+	durations := make([]time.Duration, n)
+	before, after := runtime.MemStats{}, runtime.MemStats{}
+	allocs, bytes := uint64(0), uint64(0)
+
+	for i := 0; i < n; i++ {
+		value := setup()
+
+		runtime.ReadMemStats(&before)
+		start := time.Now()
+		step(value)
+		durations[i] = time.Since(start)
+		runtime.ReadMemStats(&after)
+
+		allocs += after.Mallocs - before.Mallocs
+		bytes += after.TotalAlloc - before.TotalAlloc
+	}
+
+	return newStats(durations, allocs, bytes)
+}
+
+// newStats returns the statistics of the durations of the iterations, and of their total allocations
+// the durations are sorted in place
+func newStats(durations []time.Duration, allocs, bytes uint64) Stats {
+	n := len(durations)
+	slices.Sort(durations)
+	return Stats{
+		Min:    durations[0],
+		Median: durations[n/2],
+		P95:    durations[min(n-1, n*95/100)],
+		Allocs: allocs / uint64(n),
+		Bytes:  bytes / uint64(n),
+	}
+}
+
+// Bench measures separately the parsing and each part of the puzzle, over n iterations
+// every part iteration works on its own freshly parsed input, which is not part of the measure
+// a step whose puzzle panics records the error instead of its measures, and the error is returned
+func Bench(solver Solver, inputs *utils.Inputs, n int) (Benchmark, error) {
+	id := solver.ID()
+	benchmark := Benchmark{ID: id.String(), Iterations: n, Steps: make(map[string]Stats, 3)}
+	errs := make([]error, 0)
+	record := func(step, input string, err error) {
+		benchmark.Steps[step] = Stats{Input: input, Error: err.Error()}
+		errs = append(errs, fmt.Errorf("%v %s: %s: %w", id, step, input, err))
+	}
+
+	for part, step := range []string{StepPart1, StepPart2} {
+		name, lines, err := inputs.Read(id.Year, id.Day, part+1)
+		if err != nil {
+			return benchmark, errors.Join(append(errs, err)...)
+		}
+		input := Input{Name: name, Lines: lines}
+		parse := func() any { return solver.Parse(input) }
+
+		if part == 0 {
+			var stats Stats
+			if err := protect(func() { stats = measure(n, func() any { return nil }, func(any) { parse() }) }); err != nil {
+				record(StepParse, name, err)
+				return benchmark, errors.Join(errs...)
+			}
+			stats.Input = name
+			benchmark.Steps[StepParse] = stats
+		}
+
+		var stats Stats
+		exists := false
+		err = protect(func() {
+			if _, exists = solver.Solve(part+1, parse()); exists {
+				stats = measure(n, parse, func(parsed any) { solver.Solve(part+1, parsed) })
+			}
+		})
+		if err != nil {
+			record(step, name, err)
+		} else if exists {
+			stats.Input = name
+			benchmark.Steps[step] = stats
+		}
+	}
+	return benchmark, errors.Join(errs...)
+}
+
+// ReadBenchmarks reads benchmarks previously saved with WriteBenchmarks
+func ReadBenchmarks(path string) ([]Benchmark, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	benchmarks := []Benchmark{}
+	if err := json.Unmarshal(data, &benchmarks); err != nil {
+		return nil, fmt.Errorf("invalid benchmarks file %s: %w", path, err)
+	}
+	return benchmarks, nil
+}
+
+// WriteBenchmarks saves the benchmarks as JSON, to be used later as a baseline
+func WriteBenchmarks(path string, benchmarks []Benchmark) error {
+	data, err := json.MarshalIndent(benchmarks, "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Regression tells whether the median of a step is slower than the baseline one by more than the threshold ratio
+// it returns the relative change of the median, and false if the baseline has no such step
+// slowdowns below a microsecond are considered as noise
+func Regression(current, baseline Stats, threshold float64) (change float64, regressed bool) {
+	if baseline.Median <= 0 {
+		return 0, false
+	}
+	change = float64(current.Median-baseline.Median) / float64(baseline.Median)
+	return change, change > threshold && current.Median-baseline.Median > noiseFloor
+}
+
+// PrintBenchmark writes the benchmark statistics, compared to the baseline ones if any
+// it returns the steps that regressed by more than the threshold ratio
+func PrintBenchmark(w io.Writer, benchmark Benchmark, baseline *Benchmark, threshold float64) (regressions []string) {
+	fmt.Fprintf(w, "%s (%d iterations)\n", benchmark.ID, benchmark.Iterations)
+	for _, step := range []string{StepParse, StepPart1, StepPart2} {
+		stats, found := benchmark.Steps[step]
+		if !found {
+			continue
+		}
+		if stats.Error != "" {
+			fmt.Fprintf(w, "  %-6s %-10s error: %s\n", step, stats.Input, stats.Error)
+			continue
+		}
+		fmt.Fprintf(w, "  %-6s %-10s min %10v  median %10v  p95 %10v  allocs %8d  bytes %10d",
+			step, stats.Input, stats.Min, stats.Median, stats.P95, stats.Allocs, stats.Bytes)
+
+		// a step is only compared to the baseline measured on the same input
+		if previous, found := baseline.step(step); found && previous.Input == stats.Input && previous.Error == "" && previous.Median > 0 {
+			change, regressed := Regression(stats, previous, threshold)
+			fmt.Fprintf(w, "  %+7.1f%%", change*100)
+			if regressed {
+				fmt.Fprint(w, "  REGRESSION")
+				regressions = append(regressions, benchmark.ID+" "+step)
+			}
+		}
+		fmt.Fprintln(w)
+	}
+	return regressions
+}
+
+// step returns the statistics of the step, if the benchmark exists and has measured it
+func (benchmark *Benchmark) step(step string) (Stats, bool) {
+	if benchmark == nil {
+		return Stats{}, false
+	}
+	stats, found := benchmark.Steps[step]
+	return stats, found
+}
@@ -0,0 +1,182 @@
+package aoc
+
+import (
+	"errors"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewStats(t *testing.T) {
+	tests := []struct {
+		n                     int
+		min, median, p95      time.Duration
+		allocs, bytes         uint64
+		wantAllocs, wantBytes uint64
+	}{
+		{1, 1, 1, 1, 3, 30, 3, 30},
+		{2, 1, 2, 2, 4, 40, 2, 20},
+		{20, 1, 11, 20, 40, 400, 2, 20},
+		{100, 1, 51, 96, 250, 1000, 2, 10},
+	}
+	for _, test := range tests {
+		// durations from 1 to n, shuffled
+		durations := make([]time.Duration, test.n)
+		for i, j := range rand.Perm(test.n) {
+			durations[i] = time.Duration(j + 1)
+		}
+		want := Stats{Min: test.min, Median: test.median, P95: test.p95, Allocs: test.wantAllocs, Bytes: test.wantBytes}
+		if stats := newStats(durations, test.allocs, test.bytes); stats != want {
+			t.Errorf("newStats() of %d iterations = %+v, want %+v", test.n, stats, want)
+		}
+	}
+}
+
+// sink keeps the allocations of the benchmarked steps alive
+var sink []byte
+
+func TestMeasureAllocations(t *testing.T) {
+	const size = 1 << 20
+	stats := measure(4, func() any { return nil }, func(any) { sink = make([]byte, size) })
+	if stats.Allocs < 1 || stats.Bytes < size {
+		t.Errorf("measure() of a %d bytes allocation = %d allocs, %d bytes", size, stats.Allocs, stats.Bytes)
+	}
+
+	// the allocations of the setup are not measured
+	stats = measure(4, func() any { return make([]byte, size) }, func(value any) { sink = value.([]byte) })
+	if stats.Bytes >= size {
+		t.Errorf("measure() counts the %d bytes allocated by the setup: %d bytes", size, stats.Bytes)
+	}
+	if stats.Min > stats.Median || stats.Median > stats.P95 {
+		t.Errorf("measure() statistics are not ordered: %+v", stats)
+	}
+}
+
+func TestBench(t *testing.T) {
+	inputs := testInputs(t, `{}`)
+	if err := os.WriteFile(filepath.Join(inputs.Dir(2024, 1), "example2.txt"), []byte("4\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	benchmark, err := Bench(solver[[]int, int, int]{sumPuzzle(1)}, inputs, 3)
+	if err != nil {
+		t.Fatalf("Bench() error = %v", err)
+	}
+	if benchmark.ID != "2024/01" || benchmark.Iterations != 3 || len(benchmark.Steps) != 3 {
+		t.Errorf("Bench() = %+v", benchmark)
+	}
+	for step, input := range map[string]string{StepParse: "example", StepPart1: "example", StepPart2: "example2"} {
+		if stats := benchmark.Steps[step]; stats.Input != input || stats.Error != "" {
+			t.Errorf("Bench() %s = %+v, want input %q", step, stats, input)
+		}
+	}
+}
+
+func TestBenchPanics(t *testing.T) {
+	puzzle := sumPuzzle(1)
+	puzzle.Part1 = func(values []int) int { return values[len(values)] }
+	benchmark, err := Bench(solver[[]int, int, int]{puzzle}, testInputs(t, `{}`), 3)
+	if err == nil || !strings.HasPrefix(err.Error(), "2024/01 part1: example: panic: runtime error: index out of range") {
+		t.Errorf("Bench() error = %v", err)
+	}
+	if stats := benchmark.Steps[StepPart1]; stats.Input != "example" || !strings.HasPrefix(stats.Error, "panic: runtime error") || stats.Median != 0 {
+		t.Errorf("Bench() part1 = %+v", stats)
+	}
+	// the other steps are still measured
+	if stats := benchmark.Steps[StepPart2]; stats.Error != "" || stats.Median == 0 {
+		t.Errorf("Bench() part2 = %+v", stats)
+	}
+
+	// an invalid input stops the benchmark at the parsing
+	puzzle = sumPuzzle(1)
+	puzzle.Parse = func(Input) []int { panic(errors.New("invalid input")) }
+	benchmark, err = Bench(solver[[]int, int, int]{puzzle}, testInputs(t, `{}`), 3)
+	if err == nil || err.Error() != "2024/01 parse: example: invalid input" || len(benchmark.Steps) != 1 {
+		t.Errorf("Bench() = %+v, %v", benchmark, err)
+	}
+
+	output := strings.Builder{}
+	PrintBenchmark(&output, benchmark, nil, 0.1)
+	if !strings.Contains(output.String(), "error: invalid input") {
+		t.Errorf("PrintBenchmark() output:\n%s", output.String())
+	}
+}
+
+func TestBenchmarksFile(t *testing.T) {
+	benchmark, err := Bench(solver[[]int, int, int]{sumPuzzle(1)}, testInputs(t, `{}`), 3)
+	if err != nil {
+		t.Fatalf("Bench() error = %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "bench.json")
+	benchmarks := []Benchmark{benchmark, {ID: "2024/02", Iterations: 1, Steps: map[string]Stats{StepParse: {Input: "input", Min: 1, Median: 2, P95: 3, Allocs: 4, Bytes: 5}}}}
+	if err := WriteBenchmarks(path, benchmarks); err != nil {
+		t.Fatalf("WriteBenchmarks() error = %v", err)
+	}
+	read, err := ReadBenchmarks(path)
+	if err != nil || !reflect.DeepEqual(read, benchmarks) {
+		t.Errorf("ReadBenchmarks() = %+v, %v, want %+v", read, err, benchmarks)
+	}
+	if _, err := ReadBenchmarks(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Errorf("ReadBenchmarks() of a missing file succeeded")
+	}
+}
+
+func TestRegression(t *testing.T) {
+	tests := []struct {
+		name              string
+		current, baseline time.Duration
+		change            float64
+		regressed         bool
+	}{
+		{"slower", 150 * time.Millisecond, 100 * time.Millisecond, 0.5, true},
+		{"slower within the threshold", 105 * time.Millisecond, 100 * time.Millisecond, 0.05, false},
+		{"faster", 50 * time.Millisecond, 100 * time.Millisecond, -0.5, false},
+		{"slower below the noise floor", 900 * time.Nanosecond, 100 * time.Nanosecond, 8, false},
+		{"missing from the baseline", 100 * time.Millisecond, 0, 0, false},
+	}
+	for _, test := range tests {
+		change, regressed := Regression(Stats{Median: test.current}, Stats{Median: test.baseline}, 0.1)
+		if change != test.change || regressed != test.regressed {
+			t.Errorf("%s: Regression() = %v, %v, want %v, %v", test.name, change, regressed, test.change, test.regressed)
+		}
+	}
+}
+
+func TestPrintBenchmark(t *testing.T) {
+	benchmark := Benchmark{ID: "2024/01", Iterations: 10, Steps: map[string]Stats{
+		StepParse: {Input: "input", Median: 10 * time.Millisecond},
+		StepPart1: {Input: "input", Median: 20 * time.Millisecond},
+		StepPart2: {Input: "input", Median: 30 * time.Millisecond},
+	}}
+	baseline := Benchmark{ID: "2024/01", Iterations: 10, Steps: map[string]Stats{
+		StepParse: {Input: "input", Median: 20 * time.Millisecond}, // faster
+		StepPart1: {Input: "input", Median: 10 * time.Millisecond}, // slower
+	}}
+
+	output := strings.Builder{}
+	regressions := PrintBenchmark(&output, benchmark, &baseline, 0.1)
+	if len(regressions) != 1 || regressions[0] != "2024/01 part1" {
+		t.Errorf("PrintBenchmark() regressions = %v", regressions)
+	}
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("PrintBenchmark() output:\n%s", output.String())
+	}
+	for i, suffix := range []string{"-50.0%", "+100.0%  REGRESSION", "bytes          0"} {
+		if !strings.HasSuffix(lines[i+1], suffix) {
+			t.Errorf("PrintBenchmark() line %q, want suffix %q", lines[i+1], suffix)
+		}
+	}
+
+	// a baseline measured on another input is not compared
+	baseline.Steps[StepPart1] = Stats{Input: "example", Median: 10 * time.Millisecond}
+	baseline.Steps[StepParse] = Stats{Input: "example", Median: 20 * time.Millisecond}
+	output.Reset()
+	if regressions := PrintBenchmark(&output, benchmark, &baseline, 0.1); len(regressions) != 0 || strings.Contains(output.String(), "%") {
+		t.Errorf("PrintBenchmark() compared another input:\n%s", output.String())
+	}
+}
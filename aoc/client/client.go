@@ -0,0 +1,229 @@
+// Package client downloads the puzzle inputs and statements from adventofcode.com
+// everything downloaded is cached on disk, and requests are throttled to be gentle with the server
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBaseURL is the address of the Advent of Code website
+	DefaultBaseURL = "https://adventofcode.com"
+	// DefaultUserAgent identifies the tool to the Advent of Code server, as requested by its author
+	DefaultUserAgent = "github.com/aurelbec/advent-of-code"
+	// DefaultInterval is the minimal delay between two requests
+	DefaultInterval = 3 * time.Second
+)
+
+// Environment variables used to find the session cookie
+const (
+	EnvSession     = "AOC_SESSION"      // session cookie value
+	EnvToken       = "AOC_TOKEN"        // session cookie value, kept for compatibility with the former script
+	EnvSessionFile = "AOC_SESSION_FILE" // path of a file containing the session cookie value
+)
+
+// Names of the cached files, in each day directory
+const (
+	InputFile  = "input.txt"
+	PuzzleFile = "puzzle.html"
+)
+
+// ErrNoSession is returned when a download requires a session cookie that is not set
+var ErrNoSession = errors.New("no session cookie: set " + EnvSession + " or " + EnvSessionFile)
+
+// Client downloads puzzle inputs and statements, and caches them in Root/year/day
+type Client struct {
+	BaseURL   string
+	UserAgent string
+	Session   string
+	Root      string
+	Interval  time.Duration
+	HTTP      *http.Client
+
+	mutex       sync.Mutex
+	lastRequest time.Time
+}
+
+// New is a quick way to get a Client using the default settings, caching files in the root directory
+func New(root, session string) *Client {
+	return &Client{
+		BaseURL:   DefaultBaseURL,
+		UserAgent: DefaultUserAgent,
+		Session:   session,
+		Root:      root,
+		Interval:  DefaultInterval,
+		HTTP:      &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// LoadSession returns the session cookie from the environment, or from the session file
+// the session file defaults to $XDG_CONFIG_HOME/aoc/session (e.g. ~/.config/aoc/session)
+// it returns an empty session if none is found
+func LoadSession() (string, error) {
+	for _, env := range []string{EnvSession, EnvToken} {
+		if session := strings.TrimSpace(os.Getenv(env)); session != "" {
+			return session, nil
+		}
+	}
+
+	path := os.Getenv(EnvSessionFile)
+	if path == "" {
+		config, err := os.UserConfigDir()
+		if err != nil {
+			return "", nil
+		}
+		path = filepath.Join(config, "aoc", "session")
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) && os.Getenv(EnvSessionFile) == "" {
+		return "", nil
+	} else if err != nil {
+		return "", fmt.Errorf("reading session file: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// Dir returns the directory where the files of the day are cached
+func (client *Client) Dir(year, day int) string {
+	return filepath.Join(client.Root, fmt.Sprint(year), fmt.Sprintf("%02d", day))
+}
+
+// Input returns the personal puzzle input
+// once downloaded the input never changes, so it is never fetched again if already on disk
+func (client *Client) Input(ctx context.Context, year, day int) ([]byte, error) {
+	path := filepath.Join(client.Dir(year, day), InputFile)
+	if data, err := os.ReadFile(path); err == nil {
+		return data, nil
+	}
+
+	if client.Session == "" {
+		return nil, ErrNoSession
+	}
+	data, err := client.get(ctx, fmt.Sprintf("/%d/day/%d/input", year, day))
+	if err != nil {
+		return nil, err
+	}
+	return data, writeFile(path, data)
+}
+
+// Puzzle returns the puzzle statement page
+// the cached page is used unless refresh is set, as the page changes once the first part is solved
+func (client *Client) Puzzle(ctx context.Context, year, day int, refresh bool) ([]byte, error) {
+	path := filepath.Join(client.Dir(year, day), PuzzleFile)
+	if !refresh {
+		if data, err := os.ReadFile(path); err == nil {
+			return data, nil
+		}
+	}
+
+	data, err := client.get(ctx, fmt.Sprintf("/%d/day/%d", year, day))
+	if err != nil {
+		return nil, err
+	}
+	return data, writeFile(path, data)
+}
+
+// wait blocks until the minimal interval since the last request has elapsed, then books the next slot
+func (client *Client) wait(ctx context.Context) error {
+	client.mutex.Lock()
+	next := client.lastRequest.Add(client.Interval)
+	now := time.Now()
+	if next.Before(now) {
+		next = now
+	}
+	client.lastRequest = next
+	client.mutex.Unlock()
+
+	timer := time.NewTimer(time.Until(next))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// newRequest creates a request to the path, carrying the user agent and the session cookie if any
+func (client *Client) newRequest(ctx context.Context, method, path string, body io.Reader) (*http.Request, error) {
+	request, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(client.BaseURL, "/")+path, body)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("User-Agent", client.UserAgent)
+	if client.Session != "" {
+		request.AddCookie(&http.Cookie{Name: "session", Value: client.Session})
+	}
+	return request, nil
+}
+
+// do sends the request once the throttling allows it, and returns the response body if the status is 200
+func (client *Client) do(request *http.Request) ([]byte, error) {
+	if err := client.wait(request.Context()); err != nil {
+		return nil, err
+	}
+
+	response, err := client.HTTP.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", request.URL, err)
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return data, nil
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%s: not found, the puzzle may not be unlocked yet", request.URL)
+	case http.StatusBadRequest, http.StatusUnauthorized, http.StatusInternalServerError:
+		if strings.Contains(strings.ToLower(string(data)), "log in") {
+			return nil, fmt.Errorf("%s: %s, the session cookie is likely invalid or expired", request.URL, response.Status)
+		}
+	}
+	return nil, fmt.Errorf("%s: unexpected response %s: %.200s", request.URL, response.Status, data)
+}
+
+// get downloads the page at path
+func (client *Client) get(ctx context.Context, path string) ([]byte, error) {
+	request, err := client.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.do(request)
+}
+
+// writeFile writes the file atomically, so that an interrupted download never leaves a partial file in the cache
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,216 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeServer mimics adventofcode.com for a single puzzle, and counts the requests received
+type fakeServer struct {
+	*httptest.Server
+	requests atomic.Int32
+}
+
+func newFakeServer(t *testing.T) *fakeServer {
+	t.Helper()
+
+	server := &fakeServer{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2023/day/17/input", func(w http.ResponseWriter, r *http.Request) {
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "secret" {
+			http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte("2413432311323\n"))
+	})
+	mux.HandleFunc("/2023/day/17/answer", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.FormValue("level") != "1" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(answerPage(r.FormValue("answer"))))
+	})
+	mux.HandleFunc("/2023/day/17", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("<h2>--- Day 17: Clumsy Crucible ---</h2>"))
+	})
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		server.requests.Add(1)
+		if r.UserAgent() != DefaultUserAgent {
+			http.Error(w, "missing user agent", http.StatusForbidden)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestClient(server *fakeServer, root, session string) *Client {
+	client := New(root, session)
+	client.BaseURL = server.URL
+	client.Interval = 0
+	return client
+}
+
+func TestInputIsDownloadedOnce(t *testing.T) {
+	server := newFakeServer(t)
+	root := t.TempDir()
+	client := newTestClient(server, root, "secret")
+
+	for i := 0; i < 3; i++ {
+		data, err := client.Input(context.Background(), 2023, 17)
+		if err != nil {
+			t.Fatalf("Input() error = %v", err)
+		}
+		if string(data) != "2413432311323\n" {
+			t.Fatalf("Input() = %q", data)
+		}
+	}
+
+	if n := server.requests.Load(); n != 1 {
+		t.Errorf("server received %d requests, want 1", n)
+	}
+	if _, err := os.Stat(filepath.Join(root, "2023", "17", InputFile)); err != nil {
+		t.Errorf("input is not cached: %v", err)
+	}
+}
+
+func TestInputAlreadyOnDiskIsNotFetched(t *testing.T) {
+	server := newFakeServer(t)
+	root := t.TempDir()
+	path := filepath.Join(root, "2023", "17", InputFile)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("local\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// no session is needed either, as nothing is downloaded
+	data, err := newTestClient(server, root, "").Input(context.Background(), 2023, 17)
+	if err != nil {
+		t.Fatalf("Input() error = %v", err)
+	}
+	if string(data) != "local\n" {
+		t.Errorf("Input() = %q, want the local file", data)
+	}
+	if n := server.requests.Load(); n != 0 {
+		t.Errorf("server received %d requests, want 0", n)
+	}
+}
+
+func TestInputErrors(t *testing.T) {
+	server := newFakeServer(t)
+
+	tests := []struct {
+		name      string
+		session   string
+		day       int
+		wantError string
+	}{
+		{"no session", "", 17, ErrNoSession.Error()},
+		{"invalid session", "expired", 17, "session cookie is likely invalid"},
+		{"locked puzzle", "secret", 18, "not be unlocked yet"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := t.TempDir()
+			_, err := newTestClient(server, root, test.session).Input(context.Background(), 2023, test.day)
+			if err == nil || !strings.Contains(err.Error(), test.wantError) {
+				t.Fatalf("Input() error = %v, want %q", err, test.wantError)
+			}
+
+			// nothing is written on failure
+			if entries, _ := os.ReadDir(root); len(entries) != 0 {
+				t.Errorf("root is not empty after a failed download: %v", entries)
+			}
+		})
+	}
+}
+
+func TestPuzzleRefresh(t *testing.T) {
+	server := newFakeServer(t)
+	client := newTestClient(server, t.TempDir(), "")
+
+	for _, refresh := range []bool{false, false, true} {
+		data, err := client.Puzzle(context.Background(), 2023, 17, refresh)
+		if err != nil {
+			t.Fatalf("Puzzle() error = %v", err)
+		}
+		if !strings.Contains(string(data), "Clumsy Crucible") {
+			t.Fatalf("Puzzle() = %q", data)
+		}
+	}
+
+	if n := server.requests.Load(); n != 2 {
+		t.Errorf("server received %d requests, want 2 (first download and refresh)", n)
+	}
+}
+
+func TestRequestsAreThrottled(t *testing.T) {
+	server := newFakeServer(t)
+	client := newTestClient(server, t.TempDir(), "")
+	client.Interval = 50 * time.Millisecond
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := client.Puzzle(context.Background(), 2023, 17, true); err != nil {
+			t.Fatalf("Puzzle() error = %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 2*client.Interval {
+		t.Errorf("3 requests took %v, want at least %v", elapsed, 2*client.Interval)
+	}
+}
+
+func TestThrottlingHonorsContext(t *testing.T) {
+	server := newFakeServer(t)
+	client := newTestClient(server, t.TempDir(), "")
+	client.Interval = time.Hour
+	client.lastRequest = time.Now()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := client.Puzzle(ctx, 2023, 17, true); err != context.DeadlineExceeded {
+		t.Errorf("Puzzle() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if n := server.requests.Load(); n != 0 {
+		t.Errorf("server received %d requests, want 0", n)
+	}
+}
+
+func TestLoadSession(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "session")
+	if err := os.WriteFile(file, []byte("from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name                string
+		session, token, env string
+		want                string
+	}{
+		{"session variable", "from-env", "from-token", file, "from-env"},
+		{"token variable", "", "from-token", file, "from-token"},
+		{"session file", "", "", file, "from-file"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv(EnvSession, test.session)
+			t.Setenv(EnvToken, test.token)
+			t.Setenv(EnvSessionFile, test.env)
+
+			session, err := LoadSession()
+			if err != nil || session != test.want {
+				t.Errorf("LoadSession() = %q, %v, want %q", session, err, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GuessesFile is the name of the file recording the answers submitted, in each day directory
+const GuessesFile = "guesses.json"
+
+// ErrRefused is returned when an answer is not submitted, because it is known to be wrong or it is too soon
+var ErrRefused = errors.New("answer not submitted")
+
+// Outcome is what the server tells about a submitted answer
+type Outcome int
+
+const (
+	Unknown       Outcome = iota // the response is not understood
+	Correct                      // the answer is right
+	TooHigh                      // the answer is wrong, and too high
+	TooLow                       // the answer is wrong, and too low
+	Wrong                        // the answer is wrong, without more hints
+	TooSoon                      // the answer was not checked, as the previous one is too recent
+	AlreadySolved                // the answer was not checked, as the part is already solved or not unlocked
+)
+
+var outcomes = []string{"unknown", "correct", "too high", "too low", "wrong", "too soon", "already solved"}
+
+// String returns the outcome name
+func (outcome Outcome) String() string {
+	if outcome < 0 || int(outcome) >= len(outcomes) {
+		return outcomes[Unknown]
+	}
+	return outcomes[outcome]
+}
+
+// MarshalText encodes the outcome as its name
+func (outcome Outcome) MarshalText() ([]byte, error) {
+	return []byte(outcome.String()), nil
+}
+
+// UnmarshalText decodes an outcome name
+func (outcome *Outcome) UnmarshalText(text []byte) error {
+	for i, name := range outcomes {
+		if name == string(text) {
+			*outcome = Outcome(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown outcome %q", text)
+}
+
+// Verdict is the response of the server to a submitted answer
+type Verdict struct {
+	Outcome Outcome
+	Wait    time.Duration // delay before the next answer can be submitted
+	Message string        // text of the response
+}
+
+var (
+	extractMessage  = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	extractTags     = regexp.MustCompile(`<[^>]*>`)
+	extractLeft     = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	extractWaitMins = regexp.MustCompile(`wait (one|\d+) minutes?`)
+)
+
+// parseVerdict reads the verdict from the response page of a submitted answer
+func parseVerdict(page []byte) Verdict {
+	verdict := Verdict{}
+	if match := extractMessage.FindSubmatch(page); match != nil {
+		verdict.Message = strings.Join(strings.Fields(html.UnescapeString(extractTags.ReplaceAllString(string(match[1]), ""))), " ")
+	}
+
+	message := verdict.Message
+	switch {
+	case strings.Contains(message, "That's the right answer"):
+		verdict.Outcome = Correct
+	case strings.Contains(message, "That's not the right answer") && strings.Contains(message, "too high"):
+		verdict.Outcome = TooHigh
+	case strings.Contains(message, "That's not the right answer") && strings.Contains(message, "too low"):
+		verdict.Outcome = TooLow
+	case strings.Contains(message, "That's not the right answer"):
+		verdict.Outcome = Wrong
+	case strings.Contains(message, "You gave an answer too recently"):
+		verdict.Outcome = TooSoon
+	case strings.Contains(message, "You don't seem to be solving the right level"):
+		verdict.Outcome = AlreadySolved
+	}
+
+	if match := extractLeft.FindStringSubmatch(message); match != nil {
+		minutes, _ := strconv.Atoi(match[1])
+		seconds, _ := strconv.Atoi(match[2])
+		verdict.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if match := extractWaitMins.FindStringSubmatch(message); match != nil {
+		minutes, err := strconv.Atoi(match[1])
+		if err != nil {
+			minutes = 1
+		}
+		verdict.Wait = time.Duration(minutes) * time.Minute
+	}
+	return verdict
+}
+
+// Guess is an answer submitted, along with the response of the server
+type Guess struct {
+	Part    int           `json:"part"`
+	Answer  string        `json:"answer"`
+	Outcome Outcome       `json:"outcome"`
+	Wait    time.Duration `json:"wait,omitempty"`
+	Time    time.Time     `json:"time"`
+}
+
+// History is the list of the answers submitted for a day, oldest first
+type History []Guess
+
+// ReadHistory reads the answers submitted from the guesses file of the directory
+// a missing file is not an error, nothing has been submitted yet
+func ReadHistory(dir string) (History, error) {
+	data, err := os.ReadFile(filepath.Join(dir, GuessesFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return History{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	history := History{}
+	if err := json.Unmarshal(data, &history); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", filepath.Join(dir, GuessesFile), err)
+	}
+	return history, nil
+}
+
+// Write saves the history into the guesses file of the directory
+func (history History) Write(dir string) error {
+	data, err := json.MarshalIndent(history, "", "\t")
+	if err != nil {
+		return err
+	}
+	return writeFile(filepath.Join(dir, GuessesFile), append(data, '\n'))
+}
+
+// Check tells whether the answer of the part is worth submitting at the time given, and why not
+// it is not when the part is solved, the answer was already rejected, it is out of the bounds given by the
+// too high and too low answers, or the server asked to wait
+func (history History) Check(part int, answer string, now time.Time) error {
+	if len(history) > 0 {
+		last := history[len(history)-1]
+		if until := last.Time.Add(last.Wait); until.After(now) {
+			return fmt.Errorf("%w: wait %v before submitting again", ErrRefused, until.Sub(now).Round(time.Second))
+		}
+	}
+
+	value, err := strconv.ParseInt(answer, 10, 64)
+	numeric := err == nil
+	for _, guess := range history {
+		if guess.Part != part {
+			continue
+		}
+		switch guess.Outcome {
+		case Correct:
+			return fmt.Errorf("%w: part %d is already solved with %s", ErrRefused, part, guess.Answer)
+		case TooHigh, TooLow, Wrong:
+			if guess.Answer == answer {
+				return fmt.Errorf("%w: %s was already submitted, and is %v", ErrRefused, answer, guess.Outcome)
+			}
+		}
+
+		bound, err := strconv.ParseInt(guess.Answer, 10, 64)
+		if !numeric || err != nil {
+			continue
+		}
+		if guess.Outcome == TooHigh && value >= bound {
+			return fmt.Errorf("%w: %s is not below %s, which is too high", ErrRefused, answer, guess.Answer)
+		} else if guess.Outcome == TooLow && value <= bound {
+			return fmt.Errorf("%w: %s is not above %s, which is too low", ErrRefused, answer, guess.Answer)
+		}
+	}
+	return nil
+}
+
+// Submit posts the answer of the part, unless the guesses history tells it is not worth it
+// every answer actually submitted is recorded in the guesses history, along with the verdict
+func (client *Client) Submit(ctx context.Context, year, day, part int, answer string) (Verdict, error) {
+	if client.Session == "" {
+		return Verdict{}, ErrNoSession
+	}
+	if answer == "" {
+		return Verdict{}, fmt.Errorf("%w: the answer is empty", ErrRefused)
+	}
+
+	dir := client.Dir(year, day)
+	history, err := ReadHistory(dir)
+	if err != nil {
+		return Verdict{}, err
+	}
+	if err := history.Check(part, answer, time.Now()); err != nil {
+		return Verdict{}, err
+	}
+
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+	request, err := client.newRequest(ctx, http.MethodPost, fmt.Sprintf("/%d/day/%d/answer", year, day), strings.NewReader(form.Encode()))
+	if err != nil {
+		return Verdict{}, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	page, err := client.do(request)
+	if err != nil {
+		return Verdict{}, err
+	}
+
+	verdict := parseVerdict(page)
+	if verdict.Outcome == Unknown {
+		return verdict, fmt.Errorf("unexpected response to the answer: %.200q", verdict.Message)
+	}
+	history = append(history, Guess{Part: part, Answer: answer, Outcome: verdict.Outcome, Wait: verdict.Wait, Time: time.Now()})
+	return verdict, history.Write(dir)
+}
@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// answerPage returns the response of the fake server to an answer of 2023/17 part 1, whose solution is 102
+func answerPage(answer string) string {
+	message := "<p>That's not the right answer.  If you're stuck, make sure you're using the full input data. Please wait one minute before trying again. <a href=\"/2023/day/17\">[Return to Day 17]</a></p>"
+	value, err := strconv.Atoi(answer)
+	switch {
+	case answer == "soon":
+		message = "<p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 1m 34s left to wait. <a href=\"/2023/day/17\">[Return to Day 17]</a></p>"
+	case err != nil:
+		// not a number, the generic message is kept
+	case value == 102:
+		message = "<p>That's the right answer!  You are <span class=\"day-success\">one gold star</span> closer to restoring snow operations. <a href=\"/2023/day/17#part2\">[Continue to Part Two]</a></p>"
+	case value > 102:
+		message = "<p>That's not the right answer; your answer is too high.  Please wait one minute before trying again. <a href=\"/2023/day/17\">[Return to Day 17]</a></p>"
+	default:
+		message = "<p>That's not the right answer; your answer is too low.  Please wait one minute before trying again. <a href=\"/2023/day/17\">[Return to Day 17]</a></p>"
+	}
+	return "<!DOCTYPE html>\n<html lang=\"en-us\">\n<body>\n<main>\n<article>" + message + "</article>\n</main>\n</body>\n</html>"
+}
+
+func TestParseVerdict(t *testing.T) {
+	tests := []struct {
+		answer  string
+		outcome Outcome
+		wait    time.Duration
+	}{
+		{"102", Correct, 0},
+		{"500", TooHigh, time.Minute},
+		{"7", TooLow, time.Minute},
+		{"abc", Wrong, time.Minute},
+		{"soon", TooSoon, 94 * time.Second},
+	}
+	for _, test := range tests {
+		verdict := parseVerdict([]byte(answerPage(test.answer)))
+		if verdict.Outcome != test.outcome || verdict.Wait != test.wait {
+			t.Errorf("parseVerdict(%s) = %v, %v, want %v, %v", test.answer, verdict.Outcome, verdict.Wait, test.outcome, test.wait)
+		}
+	}
+	if verdict := parseVerdict([]byte("<article><p>You don't seem to be solving the right level.  Did you already complete it?</p></article>")); verdict.Outcome != AlreadySolved {
+		t.Errorf("parseVerdict(already solved) = %v, want %v", verdict.Outcome, AlreadySolved)
+	}
+}
+
+func TestHistoryCheck(t *testing.T) {
+	now := time.Now()
+	history := History{
+		{Part: 1, Answer: "500", Outcome: TooHigh, Wait: time.Minute, Time: now.Add(-time.Hour)},
+		{Part: 1, Answer: "7", Outcome: TooLow, Wait: time.Minute, Time: now.Add(-time.Hour)},
+		{Part: 1, Answer: "abc", Outcome: Wrong, Wait: time.Minute, Time: now.Add(-time.Hour)},
+		{Part: 2, Answer: "94", Outcome: Correct, Time: now.Add(-time.Hour)},
+	}
+
+	tests := []struct {
+		part    int
+		answer  string
+		refused bool
+	}{
+		{1, "102", false},
+		{1, "8", false},
+		{1, "499", false},
+		{1, "def", false},
+		{1, "500", true},
+		{1, "501", true},
+		{1, "7", true},
+		{1, "-3", true},
+		{1, "abc", true},
+		{2, "95", true},
+	}
+	for _, test := range tests {
+		err := history.Check(test.part, test.answer, now)
+		if refused := errors.Is(err, ErrRefused); refused != test.refused {
+			t.Errorf("Check(%d, %s) = %v, want refused %v", test.part, test.answer, err, test.refused)
+		}
+	}
+
+	// the server asked to wait after the last guess
+	recent := append(History{}, history[0])
+	recent[0].Time = now.Add(-30 * time.Second)
+	if err := recent.Check(1, "102", now); !errors.Is(err, ErrRefused) {
+		t.Errorf("Check() within the wait = %v, want refused", err)
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	server := newFakeServer(t)
+	dir := t.TempDir()
+	client := newTestClient(server, dir, "secret")
+	ctx := context.Background()
+
+	submit := func(answer string) (Verdict, error) {
+		verdict, err := client.Submit(ctx, 2023, 17, 1, answer)
+		if err == nil {
+			// skip the wait asked by the server, to not slow down the test
+			history, _ := ReadHistory(client.Dir(2023, 17))
+			history[len(history)-1].Time = time.Time{}
+			if err := history.Write(client.Dir(2023, 17)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return verdict, err
+	}
+
+	steps := []struct {
+		answer  string
+		outcome Outcome
+		refused bool
+	}{
+		{"500", TooHigh, false},
+		{"600", Unknown, true}, // above a too high answer
+		{"7", TooLow, false},
+		{"7", Unknown, true}, // already rejected
+		{"102", Correct, false},
+		{"101", Unknown, true}, // already solved
+	}
+	for _, step := range steps {
+		verdict, err := submit(step.answer)
+		if refused := errors.Is(err, ErrRefused); refused != step.refused {
+			t.Fatalf("Submit(%s) error = %v, want refused %v", step.answer, err, step.refused)
+		}
+		if !step.refused && (err != nil || verdict.Outcome != step.outcome) {
+			t.Fatalf("Submit(%s) = %v, %v, want %v", step.answer, verdict.Outcome, err, step.outcome)
+		}
+	}
+
+	if n := server.requests.Load(); n != 3 {
+		t.Errorf("server received %d requests, want 3", n)
+	}
+	history, err := ReadHistory(client.Dir(2023, 17))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 3 || history[2].Answer != "102" || history[2].Outcome != Correct {
+		t.Errorf("history = %+v, want the 3 answers submitted", history)
+	}
+}
+
+func TestSubmitTooSoon(t *testing.T) {
+	server := newFakeServer(t)
+	client := newTestClient(server, t.TempDir(), "secret")
+
+	verdict, err := client.Submit(context.Background(), 2023, 17, 1, "soon")
+	if err != nil || verdict.Outcome != TooSoon || verdict.Wait != 94*time.Second {
+		t.Fatalf("Submit() = %+v, %v, want too soon with a wait", verdict, err)
+	}
+
+	// the wait is honored locally, without asking the server
+	if _, err := client.Submit(context.Background(), 2023, 17, 1, "102"); !errors.Is(err, ErrRefused) {
+		t.Errorf("Submit() during the wait error = %v, want refused", err)
+	}
+	if n := server.requests.Load(); n != 1 {
+		t.Errorf("server received %d requests, want 1", n)
+	}
+}
@@ -0,0 +1,98 @@
+package aoc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Answer lists the types a puzzle part is allowed to return
+type Answer interface {
+	~int | ~int64 | ~uint64 | ~string
+}
+
+// Input represents a puzzle input, named after the file it comes from (example, example1, input...)
+type Input struct {
+	Name  string
+	Lines []string
+}
+
+// IsExample tells whether the input is one of the examples given in the puzzle statement
+func (input Input) IsExample() bool {
+	return strings.HasPrefix(input.Name, "example")
+}
+
+// Lines is a quick way to use a parser working on raw lines as a Puzzle parser
+func Lines[T any](parse func([]string) T) func(Input) T {
+	return func(input Input) T {
+		return parse(input.Lines)
+	}
+}
+
+// ID identifies a puzzle by its year and day
+type ID struct {
+	Year, Day int
+}
+
+// String returns the puzzle identifier formatted as year/day
+func (id ID) String() string {
+	return fmt.Sprintf("%d/%02d", id.Year, id.Day)
+}
+
+// URL returns the puzzle statement address
+func (id ID) URL() string {
+	return fmt.Sprintf("https://adventofcode.com/%d/day/%d", id.Year, id.Day)
+}
+
+// Puzzle describes how a day is solved: the input is parsed to T, then each part computes its answer from it
+// each part receives its own freshly parsed input, so it is free to modify it
+// a nil part means that there is nothing to solve for this part (e.g. the last day part 2)
+type Puzzle[T any, A1, A2 Answer] struct {
+	Year, Day int
+	Title     string
+	Parse     func(Input) T
+	Part1     func(T) A1
+	Part2     func(T) A2
+}
+
+// Solver is the type-erased view of a Puzzle used by the runner
+type Solver interface {
+	// ID returns the puzzle identifier
+	ID() ID
+	// Title returns the puzzle title
+	Title() string
+	// Parse converts the input into the representation expected by the parts
+	Parse(Input) any
+	// Solve computes the answer of the part (1 or 2) from a parsed input, and returns whether the part exists
+	Solve(part int, parsed any) (answer any, ok bool)
+}
+
+// solver adapts a Puzzle to the Solver interface
+type solver[T any, A1, A2 Answer] struct {
+	puzzle Puzzle[T, A1, A2]
+}
+
+// ID returns the puzzle identifier
+func (solver solver[T, A1, A2]) ID() ID {
+	return ID{Year: solver.puzzle.Year, Day: solver.puzzle.Day}
+}
+
+// Title returns the puzzle title
+func (solver solver[T, A1, A2]) Title() string {
+	return solver.puzzle.Title
+}
+
+// Parse converts the input into the representation expected by the parts
+func (solver solver[T, A1, A2]) Parse(input Input) any {
+	return solver.puzzle.Parse(input)
+}
+
+// Solve computes the answer of the part (1 or 2) from a parsed input, and returns whether the part exists
+func (solver solver[T, A1, A2]) Solve(part int, parsed any) (any, bool) {
+	switch {
+	case part == 1 && solver.puzzle.Part1 != nil:
+		return solver.puzzle.Part1(parsed.(T)), true
+	case part == 2 && solver.puzzle.Part2 != nil:
+		return solver.puzzle.Part2(parsed.(T)), true
+	}
+	return nil, false
+}
@@ -0,0 +1,87 @@
+package aoc
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var registry = struct {
+	sync.Mutex
+	solvers map[ID]Solver
+}{solvers: make(map[ID]Solver)}
+
+// Register adds a puzzle to the registry, it is meant to be called from the day package init function
+// registering twice the same year/day panics
+func Register[T any, A1, A2 Answer](puzzle Puzzle[T, A1, A2]) {
+	registered := solver[T, A1, A2]{puzzle}
+	if puzzle.Parse == nil {
+		panic(fmt.Sprintf("puzzle %v has no parser", registered.ID()))
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+
+	if _, found := registry.solvers[registered.ID()]; found {
+		panic(fmt.Sprintf("puzzle %v registered twice", registered.ID()))
+	}
+	registry.solvers[registered.ID()] = registered
+}
+
+// Solvers returns all the registered solvers, ordered by year then day
+func Solvers() []Solver {
+	registry.Lock()
+	defer registry.Unlock()
+
+	solvers := make([]Solver, 0, len(registry.solvers))
+	for _, solver := range registry.solvers {
+		solvers = append(solvers, solver)
+	}
+	slices.SortFunc(solvers, func(lhs, rhs Solver) int {
+		if lhs.ID().Year != rhs.ID().Year {
+			return lhs.ID().Year - rhs.ID().Year
+		}
+		return lhs.ID().Day - rhs.ID().Day
+	})
+	return solvers
+}
+
+// ParseID parses a puzzle identifier formatted as year/day
+func ParseID(s string) (ID, error) {
+	yearStr, dayStr, found := strings.Cut(s, "/")
+	year, errYear := strconv.Atoi(yearStr)
+	day, errDay := strconv.Atoi(dayStr)
+	if !found || errYear != nil || errDay != nil || day < 1 || day > 25 {
+		return ID{}, fmt.Errorf("invalid puzzle %q: expected 'year/day' with a day between 1 and 25", s)
+	}
+	return ID{Year: year, Day: day}, nil
+}
+
+// Select returns the registered solvers matching the pattern, which can be "all", a year "2023" or a day "2023/17"
+func Select(pattern string) ([]Solver, error) {
+	if pattern == "all" {
+		return Solvers(), nil
+	}
+
+	yearStr, dayStr, hasDay := strings.Cut(pattern, "/")
+	year, err := strconv.Atoi(yearStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid year in %q: expected 'all', 'year' or 'year/day'", pattern)
+	}
+	day := 0
+	if hasDay {
+		if day, err = strconv.Atoi(dayStr); err != nil || day < 1 || day > 25 {
+			return nil, fmt.Errorf("invalid day in %q: expected a number between 1 and 25", pattern)
+		}
+	}
+
+	selected := slices.DeleteFunc(Solvers(), func(solver Solver) bool {
+		return solver.ID().Year != year || (hasDay && solver.ID().Day != day)
+	})
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("no puzzle registered for %q", pattern)
+	}
+	return selected, nil
+}
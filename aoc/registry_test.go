@@ -0,0 +1,99 @@
+package aoc
+
+import "testing"
+
+// registryPuzzle returns a puzzle of the day, that has nothing to solve
+func registryPuzzle(id ID) Puzzle[[]string, int, int] {
+	return Puzzle[[]string, int, int]{Year: id.Year, Day: id.Day, Parse: func(input Input) []string { return input.Lines }}
+}
+
+// the registry is global, so the test puzzles are registered once, in years no real day uses
+func init() {
+	for _, id := range []ID{{2015, 1}, {2015, 2}, {2015, 25}, {2016, 3}} {
+		Register(registryPuzzle(id))
+	}
+}
+
+func TestParseID(t *testing.T) {
+	tests := []struct {
+		input string
+		want  ID
+		valid bool
+	}{
+		{"2015/1", ID{2015, 1}, true},
+		{"2015/07", ID{2015, 7}, true},
+		{"2015/25", ID{2015, 25}, true},
+		{"2015/0", ID{}, false},
+		{"2015/26", ID{}, false},
+		{"2015", ID{}, false},
+		{"2015/x", ID{}, false},
+		{"/1", ID{}, false},
+		{"", ID{}, false},
+	}
+	for _, test := range tests {
+		id, err := ParseID(test.input)
+		if (err == nil) != test.valid || id != test.want {
+			t.Errorf("ParseID(%q) = %v, %v, want %v", test.input, id, err, test.want)
+		}
+	}
+	if id := (ID{2015, 7}); id.String() != "2015/07" || id.URL() != "https://adventofcode.com/2015/day/7" {
+		t.Errorf("ID formatting = %s, %s", id, id.URL())
+	}
+}
+
+func TestSelect(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    []ID // selected puzzles among the test ones
+		err     string
+	}{
+		{"2015", []ID{{2015, 1}, {2015, 2}, {2015, 25}}, ""},
+		{"2016", []ID{{2016, 3}}, ""},
+		{"2015/2", []ID{{2015, 2}}, ""},
+		{"2015/02", []ID{{2015, 2}}, ""},
+		{"all", []ID{{2015, 1}, {2015, 2}, {2015, 25}, {2016, 3}}, ""},
+		{"2015/3", nil, `no puzzle registered for "2015/3"`},
+		{"2014", nil, `no puzzle registered for "2014"`},
+		{"2015/26", nil, `invalid day in "2015/26": expected a number between 1 and 25`},
+		{"2015/x", nil, `invalid day in "2015/x": expected a number between 1 and 25`},
+		{"last", nil, `invalid year in "last": expected 'all', 'year' or 'year/day'`},
+	}
+	for _, test := range tests {
+		solvers, err := Select(test.pattern)
+		if test.err != "" {
+			if err == nil || err.Error() != test.err {
+				t.Errorf("Select(%q) error = %v, want %s", test.pattern, err, test.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Select(%q) error = %v", test.pattern, err)
+			continue
+		}
+		ids := []ID{}
+		for _, solver := range solvers {
+			if solver.ID().Year <= 2016 {
+				ids = append(ids, solver.ID())
+			}
+		}
+		if len(ids) != len(test.want) {
+			t.Errorf("Select(%q) = %v, want %v", test.pattern, ids, test.want)
+			continue
+		}
+		for i := range ids {
+			if ids[i] != test.want[i] {
+				t.Errorf("Select(%q) = %v, want %v in order", test.pattern, ids, test.want)
+				break
+			}
+		}
+	}
+}
+
+func TestRegisterTwice(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "puzzle 2015/01 registered twice" {
+			t.Errorf("Register() of a registered day panicked with %v", r)
+		}
+	}()
+	Register(registryPuzzle(ID{2015, 1}))
+}
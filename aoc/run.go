@@ -0,0 +1,275 @@
+package aoc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"runtime/debug"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aurelbec/advent-of-code/utils"
+)
+
+// Result holds the answer computed for a single part, and its verification against the expected one
+type Result struct {
+	Part     int
+	Input    string
+	Answer   any // nil when the part does not exist for the puzzle
+	Duration time.Duration
+	Status   Status
+	Expected string
+}
+
+// Solve parses the input of each part, computes its answer and verifies it against the answers file
+func Solve(solver Solver, inputs *utils.Inputs) ([]Result, error) {
+	id := solver.ID()
+	answers, err := ReadAnswers(inputs.Dir(id.Year, id.Day))
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]Result, 0, 2)
+	for part := 1; part <= 2; part++ {
+		name, lines, err := inputs.Read(id.Year, id.Day, part)
+		if err != nil {
+			return results, err
+		}
+
+		start := time.Now()
+		answer, ok, err := solve(solver, part, Input{Name: name, Lines: lines})
+		if err != nil {
+			return results, fmt.Errorf("%v part %d: %s: %w", id, part, name, err)
+		}
+		result := Result{Part: part, Input: name, Duration: time.Since(start), Status: NotApplicable}
+		if ok {
+			result.Status, result.Expected = answers.Check(name, part, fmt.Sprint(answer))
+		}
+		// the answer of a part that is not applicable to the input is meaningless, such as 0 when there is no solution
+		if ok && result.Status != NotApplicable {
+			result.Answer = answer
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// solve parses the input and solves the part, a panic of the puzzle being returned as an error
+func solve(solver Solver, part int, input Input) (answer any, ok bool, err error) {
+	err = protect(func() { answer, ok = solver.Solve(part, solver.Parse(input)) })
+	if err != nil {
+		return nil, false, err
+	}
+	return answer, ok, nil
+}
+
+// protect calls the function of a puzzle, and returns its panic as an error
+// it lets a puzzle report an invalid input by panicking with an error, as utils.Must does, since parse functions cannot
+// return errors; other panics, such as runtime errors, are bugs and come with the stack trace of the panic
+func protect(function func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			var runtimeError runtime.Error
+			if invalid, isError := r.(error); isError && !errors.As(invalid, &runtimeError) {
+				err = invalid
+			} else {
+				err = fmt.Errorf("panic: %v\n%s", r, debug.Stack())
+			}
+		}
+	}()
+	function()
+	return nil
+}
+
+// Run solves the puzzle and writes its answers to w, along with their verification status
+func Run(w io.Writer, solver Solver, inputs *utils.Inputs) ([]Result, error) {
+	fmt.Fprintf(w, "--- %d Day %d: %s ---\n", solver.ID().Year, solver.ID().Day, solver.Title())
+
+	results, err := Solve(solver, inputs)
+	if err != nil && len(results) == 0 {
+		// nothing was solved, such as when the input is not found
+		return results, err
+	}
+	total := time.Duration(0)
+	for _, result := range results {
+		if result.Answer == nil {
+			fmt.Fprintf(w, "Part %d: - [%v]\n", result.Part, result.Status)
+			continue
+		}
+		if answer := fmt.Sprint(result.Answer); strings.Contains(answer, "\n") {
+			// multi-line answers start on their own line
+			fmt.Fprintf(w, "Part %d: [%v]\n%s\n", result.Part, result.Status, answer)
+		} else {
+			fmt.Fprintf(w, "Part %d: %s [%v]\n", result.Part, answer, result.Status)
+		}
+		if result.Status == Fail {
+			fmt.Fprint(w, Diff(result.Expected, fmt.Sprint(result.Answer)))
+		}
+		total += result.Duration
+	}
+	fmt.Fprintln(w, "Total time:", total.Round(time.Microsecond))
+	if err == nil && slices.ContainsFunc(results, func(result Result) bool { return result.Status == Fail }) {
+		err = fmt.Errorf("%v: wrong answer", solver.ID())
+	}
+	return results, err
+}
+
+// Default settings of RunAll
+const (
+	DefaultBudget  = 15 * time.Second // every puzzle has a solution completing within 15 seconds
+	DefaultTimeout = time.Minute
+)
+
+// Options configures RunAll
+type Options struct {
+	Workers int           // number of puzzles solved at the same time, at least 1
+	Timeout time.Duration // duration after which a puzzle is abandoned, none if zero
+	Budget  time.Duration // duration above which a puzzle is flagged as too slow, none if zero
+}
+
+// report is the outcome of running a single puzzle
+type report struct {
+	solver   Solver
+	output   []byte
+	results  []Result
+	duration time.Duration
+	err      error
+}
+
+// status returns the overall verification status of the puzzle, the worst one of its parts
+func (report report) status() string {
+	switch {
+	case errors.Is(report.err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(report.err, context.Canceled):
+		return "canceled"
+	case slices.ContainsFunc(report.results, func(result Result) bool { return result.Status == Fail }):
+		return Fail.String()
+	case report.err != nil:
+		return "error"
+	case slices.ContainsFunc(report.results, func(result Result) bool { return result.Status == Missing }):
+		return Missing.String()
+	}
+	return Pass.String()
+}
+
+// runWithTimeout runs the puzzle, giving up once the context is done
+// a solver cannot be interrupted: when giving up it keeps running in the background until it completes
+func runWithTimeout(ctx context.Context, solver Solver, inputs *utils.Inputs, timeout time.Duration) report {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	if err := ctx.Err(); err != nil {
+		return report{solver: solver, err: fmt.Errorf("%v: %w", solver.ID(), err)}
+	}
+
+	start := time.Now()
+	done := make(chan report, 1)
+	go func() {
+		buffer := bytes.Buffer{}
+		results, err := Run(&buffer, solver, inputs)
+		done <- report{solver: solver, output: buffer.Bytes(), results: results, duration: time.Since(start), err: err}
+	}()
+
+	select {
+	case report := <-done:
+		return report
+	case <-ctx.Done():
+		output := fmt.Appendf(nil, "--- %d Day %d: %s ---\ngave up after %v\n", solver.ID().Year, solver.ID().Day, solver.Title(), time.Since(start).Round(time.Millisecond))
+		return report{solver: solver, output: output, duration: time.Since(start), err: fmt.Errorf("%v: %w", solver.ID(), ctx.Err())}
+	}
+}
+
+// RunAll runs the puzzles concurrently with a pool of workers, and returns the errors encountered
+// the output of each puzzle is written in order as it completes, then a summary table of all the puzzles
+// puzzles running longer than the budget are flagged, and the ones running longer than the timeout are abandoned
+func RunAll(ctx context.Context, w io.Writer, solvers []Solver, inputs *utils.Inputs, options Options) error {
+	reports := make([]chan report, len(solvers))
+	for i := range reports {
+		reports[i] = make(chan report, 1)
+	}
+	jobs := make(chan int)
+	go func() {
+		for i := range solvers {
+			jobs <- i
+		}
+		close(jobs)
+	}()
+	for worker := 0; worker < max(options.Workers, 1); worker++ {
+		go func() {
+			for i := range jobs {
+				reports[i] <- runWithTimeout(ctx, solvers[i], inputs, options.Timeout)
+			}
+		}()
+	}
+
+	errs := make([]error, 0)
+	all := make([]report, 0, len(solvers))
+	for i := range solvers {
+		report := <-reports[i]
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		w.Write(report.output)
+		if report.err != nil {
+			errs = append(errs, report.err)
+		}
+		all = append(all, report)
+	}
+
+	if len(solvers) > 1 {
+		fmt.Fprintln(w)
+		summarize(w, all, options.Budget)
+	}
+	return errors.Join(errs...)
+}
+
+// summarize writes a table of the answers, statuses and durations of the puzzles, and the statuses counts
+func summarize(w io.Writer, reports []report, budget time.Duration) {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "day\tpart 1\tpart 2\tstatus\tduration")
+
+	statuses := make(map[Status]int)
+	slow := 0
+	for _, report := range reports {
+		answers := []string{"-", "-"}
+		for _, result := range report.results {
+			statuses[result.Status]++
+			if result.Answer != nil {
+				answers[result.Part-1] = summarizeAnswer(fmt.Sprint(result.Answer))
+			}
+		}
+		fmt.Fprintf(table, "%v\t%s\t%s\t%s\t%v", report.solver.ID(), answers[0], answers[1], report.status(), report.duration.Round(time.Microsecond))
+		if budget > 0 && report.duration > budget {
+			fmt.Fprintf(table, "\tover the %v budget", budget)
+			slow++
+		}
+		fmt.Fprintln(table)
+	}
+	table.Flush()
+
+	fmt.Fprintf(w, "\n%v: %d, %v: %d, %v: %d, %v: %d\n",
+		Pass, statuses[Pass], Fail, statuses[Fail], Missing, statuses[Missing], NotApplicable, statuses[NotApplicable])
+	if slow > 0 {
+		fmt.Fprintf(w, "%d puzzle(s) over the %v budget\n", slow, budget)
+	}
+}
+
+// summarizeAnswer shortens an answer to fit in the summary table, multi-line answers being on a single line
+func summarizeAnswer(answer string) string {
+	const width = 20
+	if line, _, multiline := strings.Cut(answer, "\n"); multiline {
+		answer = line + "…"
+	}
+	if runes := []rune(answer); len(runes) > width {
+		answer = string(runes[:width-1]) + "…"
+	}
+	return answer
+}
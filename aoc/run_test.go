@@ -0,0 +1,205 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aurelbec/advent-of-code/utils"
+)
+
+// testInputs returns inputs reading the example of the puzzles from a temporary root, along with the answers of 2024/01
+func testInputs(t *testing.T, answers string) *utils.Inputs {
+	t.Helper()
+	inputs := &utils.Inputs{Root: t.TempDir(), Source: utils.SourceExample}
+	for day := 1; day <= 25; day++ {
+		if err := os.MkdirAll(inputs.Dir(2024, day), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(inputs.Dir(2024, day), "example.txt"), []byte("1\n2\n3\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(inputs.Dir(2024, 1), AnswersFile), []byte(answers), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return inputs
+}
+
+// sumPuzzle returns a puzzle of the day summing the lines of its input, part 2 being the number of lines
+func sumPuzzle(day int) Puzzle[[]int, int, int] {
+	return Puzzle[[]int, int, int]{
+		Year:  2024,
+		Day:   day,
+		Title: "Test",
+		Parse: func(input Input) []int { return utils.Must(utils.MapLines(input.Lines, utils.ParseInt)) },
+		Part1: func(values []int) int { return utils.Sum(values) },
+		Part2: func(values []int) int { return len(values) },
+	}
+}
+
+func TestSolve(t *testing.T) {
+	inputs := testInputs(t, `{"example": {"part1": "6", "part2": null}}`)
+	results, err := Solve(solver[[]int, int, int]{sumPuzzle(1)}, inputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || results[0].Answer != 6 || results[0].Status != Pass || results[0].Input != "example" {
+		t.Errorf("Solve() part 1 = %+v", results[0])
+	}
+	// the answer of a part that is not applicable is not reported
+	if results[1].Answer != nil || results[1].Status != NotApplicable {
+		t.Errorf("Solve() part 2 = %+v, want no answer", results[1])
+	}
+}
+
+func TestSolvePanics(t *testing.T) {
+	inputs := testInputs(t, `{}`)
+	invalid := errors.New("invalid input")
+	tests := []struct {
+		name  string
+		parse func(Input) []int
+		want  string // start of the error message
+		stack bool   // whether the error comes with a stack trace
+	}{
+		{"error", func(Input) []int { panic(invalid) }, "2024/01 part 1: example: invalid input", false},
+		{"must", func(input Input) []int { return utils.Must(utils.ParseInts("1 x")) }, "2024/01 part 1: example: column 3: ", false},
+		{"string", func(Input) []int { panic("unexpected") }, "2024/01 part 1: example: panic: unexpected\ngoroutine", true},
+		{"runtime", func(input Input) []int { return []int{len(input.Lines[5])} }, "2024/01 part 1: example: panic: runtime error: index out of range", true},
+	}
+	for _, test := range tests {
+		puzzle := sumPuzzle(1)
+		puzzle.Parse = test.parse
+		_, err := Solve(solver[[]int, int, int]{puzzle}, inputs)
+		if err == nil || !strings.HasPrefix(err.Error(), test.want) {
+			t.Errorf("%s: Solve() error = %v, want %s...", test.name, err, test.want)
+			continue
+		}
+		if stack := strings.Contains(err.Error(), "run_test.go"); stack != test.stack {
+			t.Errorf("%s: Solve() error has a stack trace: %v, want %v", test.name, stack, test.stack)
+		}
+		if test.name == "error" && !errors.Is(err, invalid) {
+			t.Errorf("%s: Solve() error does not wrap the panic value", test.name)
+		}
+	}
+}
+
+// fakeSolver is a puzzle of the day 2024/day whose parts answer the day after waiting for the wait function
+type fakeSolver struct {
+	day  int
+	wait func()
+}
+
+func (solver fakeSolver) ID() ID          { return ID{Year: 2024, Day: solver.day} }
+func (solver fakeSolver) Title() string   { return "Fake" }
+func (solver fakeSolver) Parse(Input) any { return nil }
+func (solver fakeSolver) Solve(int, any) (any, bool) {
+	solver.wait()
+	return solver.day, true
+}
+
+// sleep returns a wait function sleeping for the duration
+func sleep(duration time.Duration) func() {
+	return func() { time.Sleep(duration) }
+}
+
+func TestRunAllOrder(t *testing.T) {
+	inputs := testInputs(t, `{}`)
+
+	// the first puzzles are the slowest, so they complete last, while their output is still written first
+	mutex := sync.Mutex{}
+	running, maxRunning := 0, 0
+	solvers := make([]Solver, 6)
+	for i := range solvers {
+		duration := time.Duration(len(solvers)-i) * 5 * time.Millisecond
+		solvers[i] = fakeSolver{day: i + 1, wait: func() {
+			mutex.Lock()
+			running++
+			maxRunning = max(maxRunning, running)
+			mutex.Unlock()
+
+			time.Sleep(duration)
+
+			mutex.Lock()
+			running--
+			mutex.Unlock()
+		}}
+	}
+
+	output := strings.Builder{}
+	if err := RunAll(context.Background(), &output, solvers, inputs, Options{Workers: 3}); err != nil {
+		t.Fatalf("RunAll() error = %v", err)
+	}
+	days := regexp.MustCompile(`--- 2024 Day (\d+)`).FindAllStringSubmatch(output.String(), -1)
+	if order := utils.ArrayMap(days, func(match []string) string { return match[1] }); strings.Join(order, " ") != "1 2 3 4 5 6" {
+		t.Errorf("RunAll() output order = %v", order)
+	}
+	if maxRunning > 3 {
+		t.Errorf("RunAll() ran %d puzzles at the same time with 3 workers", maxRunning)
+	}
+	if summary := output.String(); !strings.Contains(summary, "2024/06  6       6       missing") || !strings.Contains(summary, "missing: 12") {
+		t.Errorf("RunAll() summary:\n%s", summary)
+	}
+}
+
+func TestRunAllTimeout(t *testing.T) {
+	inputs := testInputs(t, `{"example": {"part1": "1", "part2": "1"}}`)
+	blocked := make(chan struct{})
+	t.Cleanup(func() { close(blocked) })
+
+	solvers := []Solver{
+		fakeSolver{day: 1, wait: func() {}},
+		fakeSolver{day: 2, wait: func() { <-blocked }},
+		fakeSolver{day: 3, wait: func() {}},
+	}
+	output := strings.Builder{}
+	err := RunAll(context.Background(), &output, solvers, inputs, Options{Workers: 2, Timeout: 20 * time.Millisecond})
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.HasPrefix(err.Error(), "2024/02: ") {
+		t.Errorf("RunAll() error = %v, want a timeout of 2024/02", err)
+	}
+
+	summary := output.String()
+	for _, want := range []string{"gave up after", "2024/01  1       1       pass", "2024/02  -       -       timeout", "2024/03  3       3       missing"} {
+		if !strings.Contains(summary, want) {
+			t.Errorf("RunAll() output does not contain %q:\n%s", want, summary)
+		}
+	}
+
+	// a canceled run abandons the puzzles not completed yet
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := RunAll(ctx, &strings.Builder{}, solvers, inputs, Options{Workers: 1}); !errors.Is(err, context.Canceled) {
+		t.Errorf("RunAll() canceled error = %v", err)
+	}
+}
+
+func TestRunAllBudget(t *testing.T) {
+	inputs := testInputs(t, `{}`)
+	solvers := []Solver{
+		fakeSolver{day: 1, wait: func() {}},
+		fakeSolver{day: 2, wait: sleep(30 * time.Millisecond)},
+	}
+	output := strings.Builder{}
+	if err := RunAll(context.Background(), &output, solvers, inputs, Options{Workers: 2, Budget: 20 * time.Millisecond}); err != nil {
+		t.Fatalf("RunAll() error = %v", err)
+	}
+
+	lines := strings.Split(output.String(), "\n")
+	flagged := slices.IndexFunc(lines, func(line string) bool { return strings.HasSuffix(line, "over the 20ms budget") })
+	if flagged < 0 || !strings.HasPrefix(lines[flagged], "2024/02") {
+		t.Errorf("RunAll() does not flag 2024/02 over the budget:\n%s", output.String())
+	}
+	if slices.ContainsFunc(lines, func(line string) bool { return strings.HasPrefix(line, "2024/01") && strings.Contains(line, "budget") }) {
+		t.Errorf("RunAll() flags 2024/01 over the budget:\n%s", output.String())
+	}
+	if !strings.Contains(output.String(), "1 puzzle(s) over the 20ms budget") {
+		t.Errorf("RunAll() does not count the puzzles over the budget:\n%s", output.String())
+	}
+}
@@ -0,0 +1,282 @@
+// Package scaffold creates the directory of a new day from text/template templates
+package scaffold
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/aurelbec/advent-of-code/aoc"
+)
+
+// TemplateExt is the extension of the template files, removed from the generated file names
+const TemplateExt = ".tmpl"
+
+// TestFile is the test file of a day, generated from the answers file by aoc generate
+const TestFile = "solution_test.go"
+
+// DaysFile is the file importing every day package into the aoc command, relative to the root
+var DaysFile = filepath.Join("cmd", "aoc", "days.go")
+
+//go:embed templates/*.tmpl
+var defaultTemplates embed.FS
+
+// Data is the data available to the templates
+type Data struct {
+	Year    int
+	Day     int
+	Title   string
+	URL     string
+	Package string // package name of the day, e.g. day05
+	Cases   []Case // expected answers, for the test file
+}
+
+// Case is an expected answer of the day, from which a test case is generated
+type Case struct {
+	Input  string
+	Part   int
+	Answer string
+}
+
+// Cases returns the expected answers as test cases, by input then part
+// the parts without answer, either missing or not applicable, have no case
+func Cases(answers aoc.Answers) []Case {
+	cases := make([]Case, 0, 2*len(answers))
+	for input := range answers {
+		for part := 1; part <= 2; part++ {
+			if answer, found := answers.Expected(input, part); found && answer != "" {
+				cases = append(cases, Case{Input: input, Part: part, Answer: answer})
+			}
+		}
+	}
+	slices.SortFunc(cases, func(a, b Case) int {
+		if a.Input != b.Input {
+			return strings.Compare(a.Input, b.Input)
+		}
+		return a.Part - b.Part
+	})
+	return cases
+}
+
+// NewData is a quick way to get the Data of a day
+func NewData(year, day int, title string) Data {
+	return Data{
+		Year:    year,
+		Day:     day,
+		Title:   title,
+		URL:     fmt.Sprintf("https://adventofcode.com/%d/day/%d", year, day),
+		Package: fmt.Sprintf("day%02d", day),
+	}
+}
+
+// Scaffolder generates the files of a new day in Root/year/day
+type Scaffolder struct {
+	Root      string
+	Templates string // optional directory of user templates, overriding the default ones having the same name
+	Force     bool   // overwrite the Go files of an existing day, keeping its answers and examples
+}
+
+// templates returns the templates by generated file name, the user ones taking precedence over the default ones
+func (scaffolder Scaffolder) templates() (map[string]*template.Template, error) {
+	templates := make(map[string]*template.Template)
+	load := func(fsys fs.FS, pattern string) error {
+		paths, err := fs.Glob(fsys, pattern)
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			content, err := fs.ReadFile(fsys, path)
+			if err != nil {
+				return err
+			}
+			name := strings.TrimSuffix(filepath.Base(path), TemplateExt)
+			tmpl, err := template.New(name).Option("missingkey=error").Parse(string(content))
+			if err != nil {
+				return fmt.Errorf("template %s: %w", path, err)
+			}
+			templates[name] = tmpl
+		}
+		return nil
+	}
+
+	if err := load(defaultTemplates, "templates/*"+TemplateExt); err != nil {
+		return nil, err
+	}
+	if scaffolder.Templates != "" {
+		if err := load(os.DirFS(scaffolder.Templates), "*"+TemplateExt); err != nil {
+			return nil, err
+		}
+	}
+	return templates, nil
+}
+
+// Dir returns the directory of the day
+func (scaffolder Scaffolder) Dir(year, day int) string {
+	return filepath.Join(scaffolder.Root, fmt.Sprint(year), fmt.Sprintf("%02d", day))
+}
+
+// Generate renders every template into the day directory, and returns the paths of the files created
+// it refuses to overwrite the files of a day that already exists, unless forced: then only the Go files are
+// regenerated, the existing data files, such as the answers and the examples, being kept
+// other files, such as the cached input and statement, are left untouched
+func (scaffolder Scaffolder) Generate(data Data) ([]string, error) {
+	dir := scaffolder.Dir(data.Year, data.Day)
+	templates, err := scaffolder.templates()
+	if err != nil {
+		return nil, err
+	}
+	for name := range templates {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			continue
+		}
+		if !scaffolder.Force {
+			return nil, fmt.Errorf("%s already exists, use force to overwrite it", filepath.Join(dir, name))
+		}
+		if filepath.Ext(name) != ".go" {
+			delete(templates, name)
+		}
+	}
+
+	// render everything before writing anything, to not leave a partial day on error
+	files := make(map[string][]byte, len(templates))
+	for name, tmpl := range templates {
+		content, err := render(tmpl, data)
+		if err != nil {
+			return nil, err
+		}
+		files[filepath.Join(dir, name)] = content
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	paths := make([]string, 0, len(files))
+	for path, content := range files {
+		if err := os.WriteFile(path, content, 0o644); err != nil {
+			return paths, err
+		}
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	return paths, nil
+}
+
+// Days returns the year/day directories of the root holding Go files, sorted
+func Days(root string) ([]aoc.ID, error) {
+	goFiles, err := filepath.Glob(filepath.Join(root, "[0-9][0-9][0-9][0-9]", "[0-9][0-9]", "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	days := make([]aoc.ID, 0, len(goFiles))
+	for _, goFile := range goFiles {
+		dir, err := filepath.Rel(root, filepath.Dir(goFile))
+		if err != nil {
+			return nil, err
+		}
+		id, err := aoc.ParseID(filepath.ToSlash(dir))
+		if err != nil {
+			return nil, err
+		}
+		days = append(days, id)
+	}
+	slices.SortFunc(days, func(a, b aoc.ID) int { return strings.Compare(a.String(), b.String()) })
+	return slices.Compact(days), nil
+}
+
+// render executes the template, formatting the Go files
+func render(tmpl *template.Template, data Data) ([]byte, error) {
+	buffer := bytes.Buffer{}
+	if err := tmpl.Execute(&buffer, data); err != nil {
+		return nil, fmt.Errorf("template %s: %w", tmpl.Name(), err)
+	}
+	content := buffer.Bytes()
+	if filepath.Ext(tmpl.Name()) != ".go" {
+		return content, nil
+	}
+	content, err := format.Source(content)
+	if err != nil {
+		return nil, fmt.Errorf("template %s produces invalid Go code: %w", tmpl.Name(), err)
+	}
+	return content, nil
+}
+
+// GenerateTests rewrites the test file of every day from its answers file, and returns the paths of the files written
+// the test file is generated, it is always overwritten
+func (scaffolder Scaffolder) GenerateTests() ([]string, error) {
+	templates, err := scaffolder.templates()
+	if err != nil {
+		return nil, err
+	}
+	tmpl, found := templates[TestFile]
+	if !found {
+		return nil, fmt.Errorf("no %s template", TestFile+TemplateExt)
+	}
+	days, err := Days(scaffolder.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(days))
+	for _, id := range days {
+		dir := scaffolder.Dir(id.Year, id.Day)
+		answers, err := aoc.ReadAnswers(dir)
+		if err != nil {
+			return paths, err
+		}
+		data := NewData(id.Year, id.Day, "")
+		data.Cases = Cases(answers)
+		content, err := render(tmpl, data)
+		if err != nil {
+			return paths, err
+		}
+		path := filepath.Join(dir, TestFile)
+		if err := os.WriteFile(path, content, 0o644); err != nil {
+			return paths, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// GenerateDays rewrites the file importing every day package into the aoc command
+func GenerateDays(root, module string) error {
+	days, err := Days(root)
+	if err != nil {
+		return err
+	}
+
+	buffer := bytes.Buffer{}
+	fmt.Fprint(&buffer, "// Code generated by aoc generate; DO NOT EDIT.\n\n// Days registration: importing a day package registers its puzzle\n\npackage main\n\nimport (\n")
+	for _, day := range days {
+		fmt.Fprintf(&buffer, "\t_ %q\n", module+"/"+day.String())
+	}
+	fmt.Fprint(&buffer, ")\n")
+
+	content, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(root, DaysFile), content, 0o644)
+}
+
+// ReadModule returns the module path declared in the go.mod of the root
+func ReadModule(root string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if module, found := strings.CutPrefix(strings.TrimSpace(line), "module "); found {
+			return strings.Trim(strings.TrimSpace(module), `"`), nil
+		}
+	}
+	return "", errors.New("no module declared in go.mod")
+}
@@ -0,0 +1,218 @@
+package scaffold
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aurelbec/advent-of-code/aoc"
+)
+
+// readFile returns the content of the file, failing the test if it cannot be read
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(content)
+}
+
+func TestGenerate(t *testing.T) {
+	scaffolder := Scaffolder{Root: t.TempDir()}
+	dir := scaffolder.Dir(2024, 5)
+	paths, err := scaffolder.Generate(NewData(2024, 5, "Print Queue"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{}
+	for _, name := range []string{"answers.json", "example.txt", "solution.go", TestFile} {
+		want = append(want, filepath.Join(dir, name))
+	}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("Generate() = %v, want %v", paths, want)
+	}
+	solution := readFile(t, filepath.Join(dir, "solution.go"))
+	for _, expected := range []string{"// https://adventofcode.com/2024/day/5", "package day05", `Title: "Print Queue"`} {
+		if !strings.Contains(solution, expected) {
+			t.Errorf("solution.go does not contain %q:\n%s", expected, solution)
+		}
+	}
+
+	// an existing day is not overwritten
+	if err := os.WriteFile(filepath.Join(dir, "solution.go"), []byte("package day05\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := scaffolder.Generate(NewData(2024, 5, "Print Queue")); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("Generate() of an existing day error = %v", err)
+	}
+	if content := readFile(t, filepath.Join(dir, "solution.go")); content != "package day05\n" {
+		t.Errorf("Generate() overwrote an existing day:\n%s", content)
+	}
+}
+
+func TestGenerateForce(t *testing.T) {
+	scaffolder := Scaffolder{Root: t.TempDir()}
+	dir := scaffolder.Dir(2024, 5)
+	if _, err := scaffolder.Generate(NewData(2024, 5, "Print Queue")); err != nil {
+		t.Fatal(err)
+	}
+	data := map[string]string{
+		"answers.json": `{"example": {"part1": "143"}}`,
+		"example.txt":  "47|53\n",
+		"solution.go":  "package day05\n",
+	}
+	for name, content := range data {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// only the Go files are regenerated, the answers and examples are kept
+	scaffolder.Force = true
+	paths, err := scaffolder.Generate(NewData(2024, 5, "Print Queue"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{filepath.Join(dir, "solution.go"), filepath.Join(dir, TestFile)}; !reflect.DeepEqual(paths, want) {
+		t.Errorf("Generate() = %v, want %v", paths, want)
+	}
+	for _, name := range []string{"answers.json", "example.txt"} {
+		if content := readFile(t, filepath.Join(dir, name)); content != data[name] {
+			t.Errorf("Generate() overwrote %s: %q", name, content)
+		}
+	}
+	if content := readFile(t, filepath.Join(dir, "solution.go")); !strings.Contains(content, `Title: "Print Queue"`) {
+		t.Errorf("Generate() did not regenerate solution.go:\n%s", content)
+	}
+}
+
+func TestGenerateTemplates(t *testing.T) {
+	templates := t.TempDir()
+	for name, content := range map[string]string{
+		"example.txt.tmpl": "example of {{.Year}}/{{.Day}}\n", // overrides a default template
+		"notes.md.tmpl":    "# {{.Title}}\n",                  // completes the default templates
+	} {
+		if err := os.WriteFile(filepath.Join(templates, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	scaffolder := Scaffolder{Root: t.TempDir(), Templates: templates}
+	dir := scaffolder.Dir(2024, 5)
+	paths, err := scaffolder.Generate(NewData(2024, 5, "Print Queue"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) != 5 {
+		t.Errorf("Generate() = %v", paths)
+	}
+	for name, want := range map[string]string{"example.txt": "example of 2024/5\n", "notes.md": "# Print Queue\n"} {
+		if content := readFile(t, filepath.Join(dir, name)); content != want {
+			t.Errorf("%s = %q, want %q", name, content, want)
+		}
+	}
+
+	// an invalid template is reported before anything is written
+	if err := os.WriteFile(filepath.Join(templates, "solution.go.tmpl"), []byte("package {{.Package}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	scaffolder.Root = t.TempDir()
+	if _, err := scaffolder.Generate(NewData(2024, 5, "Print Queue")); err == nil || !strings.Contains(err.Error(), "solution.go.tmpl") {
+		t.Errorf("Generate() with an invalid template error = %v", err)
+	}
+	if _, err := os.Stat(scaffolder.Dir(2024, 5)); err == nil {
+		t.Errorf("Generate() with an invalid template created the day")
+	}
+}
+
+func TestCases(t *testing.T) {
+	answer := func(answer string) *string { return &answer }
+	answers := aoc.Answers{
+		"input":    {"part1": answer("42"), "part2": answer("")}, // empty, that is missing
+		"example2": {"part2": answer("281")},
+		"example1": {"part1": answer("142"), "part2": nil}, // not applicable
+		"example3": {},
+	}
+	want := []Case{{"example1", 1, "142"}, {"example2", 2, "281"}, {"input", 1, "42"}}
+	if cases := Cases(answers); !reflect.DeepEqual(cases, want) {
+		t.Errorf("Cases() = %v, want %v", cases, want)
+	}
+}
+
+func TestGenerateTests(t *testing.T) {
+	scaffolder := Scaffolder{Root: t.TempDir()}
+	dir := scaffolder.Dir(2023, 1)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range map[string]string{
+		"solution.go":  "package day01\n",
+		"answers.json": `{"example1": {"part1": "142"}, "example2": {"part1": null, "part2": "281"}}`,
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	paths, err := scaffolder.GenerateTests()
+	if want := []string{filepath.Join(dir, TestFile)}; err != nil || !reflect.DeepEqual(paths, want) {
+		t.Fatalf("GenerateTests() = %v, %v, want %v", paths, err, want)
+	}
+	content := readFile(t, filepath.Join(dir, TestFile))
+	for _, expected := range []string{
+		"package day01",
+		"var cases = []aoctest.Case{\n\t{Input: \"example1\", Part: 1, Answer: \"142\"},\n\t{Input: \"example2\", Part: 2, Answer: \"281\"},\n}",
+		"aoctest.Test(t, 2023, 1, cases)",
+		"aoctest.Benchmark(b, 2023, 1, cases)",
+	} {
+		if !strings.Contains(content, expected) {
+			t.Errorf("%s does not contain %q:\n%s", TestFile, expected, content)
+		}
+	}
+}
+
+func TestGenerateDays(t *testing.T) {
+	root := t.TempDir()
+	for _, dir := range []string{"2023/01", "2015/25", "2015/03", "2015/04", "cmd/aoc"} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, path := range []string{"2023/01/solution.go", "2023/01/solution_test.go", "2015/25/solution.go", "2015/03/solution.go", "2015/04/input.txt"} {
+		if err := os.WriteFile(filepath.Join(root, path), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := GenerateDays(root, "example.com/aoc"); err != nil {
+		t.Fatal(err)
+	}
+	want := `// Code generated by aoc generate; DO NOT EDIT.
+
+// Days registration: importing a day package registers its puzzle
+
+package main
+
+import (
+	_ "example.com/aoc/2015/03"
+	_ "example.com/aoc/2015/25"
+	_ "example.com/aoc/2023/01"
+)
+`
+	if content := readFile(t, filepath.Join(root, DaysFile)); content != want {
+		t.Errorf("GenerateDays() wrote:\n%s\nwant:\n%s", content, want)
+	}
+}
+
+func TestReadModule(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/aoc\n\ngo 1.21\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if module, err := ReadModule(root); module != "example.com/aoc" || err != nil {
+		t.Errorf("ReadModule() = %q, %v", module, err)
+	}
+}
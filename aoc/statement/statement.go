@@ -0,0 +1,176 @@
+// Package statement extracts the examples and the answers from a puzzle statement page
+package statement
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+
+	"github.com/aurelbec/advent-of-code/aoc"
+	"github.com/aurelbec/advent-of-code/utils"
+)
+
+// Part is what a statement tells about one part of the puzzle
+type Part struct {
+	Example       string // first example block of the part, empty if it has none
+	ExampleAnswer string // answer of the example, the last emphasised code of the part
+	Answer        string // answer already given for the personal input, empty until the part is solved
+}
+
+// Statement is what a puzzle statement page tells, part 2 only being there once part 1 is solved
+type Statement struct {
+	Title string
+	Parts []Part
+}
+
+var (
+	extractTitle   = regexp.MustCompile(`<h2>--- Day \d+: (.*?) ---</h2>`)
+	extractArticle = regexp.MustCompile(`(?s)<article class="day-desc">(.*?)</article>`)
+	extractExample = regexp.MustCompile(`(?s)<pre><code>(.*?)</code></pre>`)
+	extractAnswer  = regexp.MustCompile(`(?s)<code><em>(.*?)</em></code>`)
+	extractGiven   = regexp.MustCompile(`(?s)Your puzzle answer was <code>(.*?)</code>`)
+	extractTags    = regexp.MustCompile(`<[^>]*>`)
+)
+
+// text returns the text of an HTML fragment, without its tags and entities
+func text(fragment []byte) string {
+	return html.UnescapeString(extractTags.ReplaceAllString(string(fragment), ""))
+}
+
+// Parse extracts the title and the parts from a statement page
+// every part is a day-desc article, the answer given for the personal input following it once solved
+func Parse(page []byte) Statement {
+	statement := Statement{}
+	if match := extractTitle.FindSubmatch(page); match != nil {
+		statement.Title = html.UnescapeString(string(match[1]))
+	}
+
+	articles := extractArticle.FindAllSubmatchIndex(page, -1)
+	for i, article := range articles {
+		content := page[article[2]:article[3]]
+		part := Part{}
+		if match := extractExample.FindSubmatch(content); match != nil {
+			part.Example = text(match[1])
+		}
+		if matches := extractAnswer.FindAllSubmatch(content, -1); len(matches) > 0 {
+			part.ExampleAnswer = text(matches[len(matches)-1][1])
+		}
+
+		// the answer given is between the end of the article and the next one
+		end := len(page)
+		if i+1 < len(articles) {
+			end = articles[i+1][0]
+		}
+		if match := extractGiven.FindSubmatch(page[article[1]:end]); match != nil {
+			part.Answer = text(match[1])
+		}
+		statement.Parts = append(statement.Parts, part)
+	}
+	return statement
+}
+
+// Examples returns the example inputs by input name
+// the example of part 1 is "example", shared with part 2 unless part 2 has a different one named "example2"
+func (statement Statement) Examples() map[string]string {
+	examples := make(map[string]string, 2)
+	for part := range statement.Parts {
+		if name := statement.exampleName(part + 1); name != "" && examples[name] == "" {
+			examples[name] = statement.Parts[part].Example
+		}
+	}
+	return examples
+}
+
+// exampleName returns the name of the example input of the part, empty if there is none
+func (statement Statement) exampleName(part int) string {
+	switch {
+	case part == 1 && statement.Parts[0].Example != "":
+		return utils.SourceExample
+	case part == 2 && statement.Parts[1].Example != "" && statement.Parts[1].Example != statement.Parts[0].Example:
+		return fmt.Sprintf("%s%d", utils.SourceExample, part)
+	case part == 2:
+		return statement.exampleName(1)
+	}
+	return ""
+}
+
+// Answers returns the answers of the examples, and the ones given for the personal input
+func (statement Statement) Answers() aoc.Answers {
+	answers := aoc.Answers{}
+	add := func(input string, part int, answer string) {
+		if input == "" || answer == "" {
+			return
+		}
+		if answers[input] == nil {
+			answers[input] = make(map[string]*string)
+		}
+		answers[input][fmt.Sprintf("part%d", part)] = &answer
+	}
+	for part := range statement.Parts {
+		add(statement.exampleName(part+1), part+1, statement.Parts[part].ExampleAnswer)
+		add(utils.SourceInput, part+1, statement.Parts[part].Answer)
+	}
+	return answers
+}
+
+// Save writes the examples and the answers into the day directory, and returns the paths of the files written
+// existing examples and answers are kept unless forced, empty example files being stubs they are always replaced
+// the example of part 1 is saved as example1.txt rather than example.txt if the day already uses this name
+func (statement Statement) Save(dir string, force bool) ([]string, error) {
+	rename := func(name string) string {
+		if name != utils.SourceExample {
+			return name
+		}
+		if _, err := os.Stat(filepath.Join(dir, utils.SourceExample+"1.txt")); err == nil {
+			return utils.SourceExample + "1"
+		}
+		return name
+	}
+
+	paths := make([]string, 0)
+	for name, example := range statement.Examples() {
+		path := filepath.Join(dir, rename(name)+".txt")
+		if info, err := os.Stat(path); err == nil && info.Size() > 0 && !force {
+			continue
+		} else if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return paths, err
+		}
+		if err := os.WriteFile(path, []byte(example), 0o644); err != nil {
+			return paths, err
+		}
+		paths = append(paths, path)
+	}
+
+	slices.Sort(paths)
+
+	answers, err := aoc.ReadAnswers(dir)
+	if err != nil {
+		return paths, err
+	}
+	changed := false
+	for input, parts := range statement.Answers() {
+		input = rename(input)
+		for part, answer := range parts {
+			if _, found := answers[input][part]; found && !force {
+				continue
+			}
+			if answers[input] == nil {
+				answers[input] = make(map[string]*string)
+			}
+			answers[input][part] = answer
+			changed = true
+		}
+	}
+	if !changed {
+		return paths, nil
+	}
+	if err := aoc.WriteAnswers(dir, answers); err != nil {
+		return paths, err
+	}
+	return append(paths, filepath.Join(dir, aoc.AnswersFile)), nil
+}
@@ -0,0 +1,174 @@
+package statement
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aurelbec/advent-of-code/aoc"
+)
+
+func readFixture(t *testing.T, name string) Statement {
+	t.Helper()
+	page, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Parse(page)
+}
+
+// flatten returns the answers as input/part -> answer, to ease comparisons
+func flatten(answers aoc.Answers) map[string]string {
+	flat := make(map[string]string)
+	for input, parts := range answers {
+		for part, answer := range parts {
+			flat[input+"/"+part] = "null"
+			if answer != nil {
+				flat[input+"/"+part] = *answer
+			}
+		}
+	}
+	return flat
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		fixture  string
+		title    string
+		parts    int
+		examples map[string]string
+		answers  map[string]string
+	}{
+		{
+			fixture: "part1.html",
+			title:   "Gear Ratios",
+			parts:   1,
+			examples: map[string]string{
+				"example": "467..114..\n...*......\n..35..633.\n......#...\n617*......\n.....+.58.\n..592.....\n......755.\n...$.*....\n.664.598..\n",
+			},
+			answers: map[string]string{"example/part1": "4361"},
+		},
+		{
+			fixture: "two-examples.html",
+			title:   "Trebuchet?!",
+			parts:   2,
+			examples: map[string]string{
+				"example":  "1abc2\npqr3stu8vwx\na1b2c3d4e5f\ntreb7uchet\n",
+				"example2": "two1nine\neightwothree\nabcone2threexyz\nxtwone3four\n4nineeightseven2\nzoneight234\n7pqrstsixteen\n",
+			},
+			answers: map[string]string{
+				"example/part1":  "142",
+				"example2/part2": "281",
+				"input/part1":    "54573",
+				"input/part2":    "54591",
+			},
+		},
+		{
+			fixture: "shared-example.html",
+			title:   "Distress Signal & Packets",
+			parts:   2,
+			examples: map[string]string{
+				"example": "[1,1,3,1,1]\n[1,1,5,1,1]\n\n[[1],[2,3,4]]\n[[1],4]\n\n[9]\n[[8,7,6]]\n\n[[4,4],4,4]\n[[4,4],4,4,4]\n\n[7,7,7,7]\n[7,7,7]\n\n[]\n[3]\n\n[[[]]]\n[[]]\n\n[1,[2,[3,[4,[5,6,7]]]],8,9]\n[1,[2,[3,[4,[5,6,0]]]],8,9]\n",
+			},
+			answers: map[string]string{
+				"example/part1": "13",
+				"example/part2": "140",
+				"input/part1":   "5393",
+				"input/part2":   "26712",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			statement := readFixture(t, test.fixture)
+			if statement.Title != test.title {
+				t.Errorf("Title = %q, want %q", statement.Title, test.title)
+			}
+			if len(statement.Parts) != test.parts {
+				t.Errorf("%d parts, want %d", len(statement.Parts), test.parts)
+			}
+			if examples := statement.Examples(); !maps.Equal(examples, test.examples) {
+				t.Errorf("Examples() = %q, want %q", examples, test.examples)
+			}
+			if answers := flatten(statement.Answers()); !maps.Equal(answers, test.answers) {
+				t.Errorf("Answers() = %v, want %v", answers, test.answers)
+			}
+		})
+	}
+}
+
+func TestSaveKeepsExistingFiles(t *testing.T) {
+	dir := t.TempDir()
+	statement := readFixture(t, "two-examples.html")
+
+	// an empty stub is replaced, while an example and an answer written by hand are kept
+	if err := os.WriteFile(filepath.Join(dir, "example.txt"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "example2.txt"), []byte("by hand\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, aoc.AnswersFile), []byte(`{"example": {"part1": "0", "part2": null}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	paths, err := statement.Save(dir, false)
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if len(paths) != 2 {
+		t.Errorf("Save() wrote %v, want example.txt and %s", paths, aoc.AnswersFile)
+	}
+
+	for name, want := range map[string]string{"example.txt": statement.Parts[0].Example, "example2.txt": "by hand\n"} {
+		if data, _ := os.ReadFile(filepath.Join(dir, name)); string(data) != want {
+			t.Errorf("%s = %q, want %q", name, data, want)
+		}
+	}
+	answers, err := aoc.ReadAnswers(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"example/part1":  "0",
+		"example/part2":  "null",
+		"example2/part2": "281",
+		"input/part1":    "54573",
+		"input/part2":    "54591",
+	}
+	if flat := flatten(answers); !maps.Equal(flat, want) {
+		t.Errorf("answers = %v, want %v", flat, want)
+	}
+
+	// saving again has nothing to do
+	if paths, err := statement.Save(dir, false); err != nil || len(paths) != 0 {
+		t.Errorf("Save() again = %v, %v, want nothing written", paths, err)
+	}
+
+	// unless forced
+	if _, err := statement.Save(dir, true); err != nil {
+		t.Fatal(err)
+	}
+	if answers, _ := aoc.ReadAnswers(dir); *answers["example"]["part1"] != "142" {
+		t.Errorf("forced example/part1 = %s, want 142", *answers["example"]["part1"])
+	}
+}
+
+func TestSaveUsesExistingExampleNames(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "example1.txt"), []byte("1abc2\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := readFixture(t, "two-examples.html").Save(dir, false); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "example.txt")); err == nil {
+		t.Error("example.txt is written while the day uses example1.txt")
+	}
+	answers, _ := aoc.ReadAnswers(dir)
+	if _, found := answers["example1"]["part1"]; !found {
+		t.Errorf("answers = %v, want the answer of part 1 recorded for example1", flatten(answers))
+	}
+}
@@ -0,0 +1,433 @@
+// aoc runs the registered Advent of Code puzzles
+//
+// usage:
+//
+//	aoc run [-input example|input|-|path] [-root dir] [-j workers] [-timeout 1m] [-budget 15s] all|year|year/day
+//	aoc bench [-n 10] [-save file] [-baseline file] [-threshold 0.2] all|year|year/day
+//	aoc fetch [-root dir] [-refresh] [-force] year/day
+//	aoc submit [-input input|path] [-root dir] year/day part
+//	aoc new [-root dir] [-force] [-templates dir] [-title title] [-offline] year/day
+//	aoc generate [-root dir] [-templates dir]
+//	aoc list [all|year]
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/aurelbec/advent-of-code/aoc"
+	"github.com/aurelbec/advent-of-code/aoc/client"
+	"github.com/aurelbec/advent-of-code/aoc/scaffold"
+	"github.com/aurelbec/advent-of-code/aoc/statement"
+	"github.com/aurelbec/advent-of-code/utils"
+)
+
+const usage = `usage:
+  aoc run [flags] all|year|year/day    solve the selected puzzles
+  aoc bench [flags] all|year|year/day  benchmark parse, part 1 and part 2 of the selected puzzles
+  aoc fetch [flags] year/day           download the puzzle input and statement, and extract its examples and answers
+  aoc submit [flags] year/day part     solve the part of the puzzle and submit its answer
+  aoc new [flags] year/day             create the directory of a new day
+  aoc generate [flags]                 regenerate the registration of the days and their tests
+  aoc list [all|year]                  list the registered puzzles
+
+run and bench flags:
+  -input example|input|-|path    input to solve (default from $AOC_INPUT, else example)
+  -root dir                      directory containing the year/day directories (default from $AOC_ROOT)
+
+run flags:
+  -j workers                     number of puzzles solved at the same time (default the number of CPUs)
+  -timeout duration              abandon a puzzle running longer than this, 0 for no timeout (default 1m)
+  -budget duration               flag a puzzle running longer than this (default 15s)
+
+bench flags:
+  -n iterations                  number of iterations of each step (default 10)
+  -save file                     save the results as JSON
+  -baseline file                 compare the results to previously saved ones
+  -threshold ratio               median slowdown above which a step is a regression (default 0.2)
+
+fetch flags:
+  -root dir                      directory containing the year/day directories (default from $AOC_ROOT)
+  -refresh                       download the statement again, e.g. to get part 2 once part 1 is solved
+  -force                         overwrite the examples and answers already recorded
+
+submit flags:
+  -input input|path              input to solve (default input)
+  -root dir                      directory containing the year/day directories (default from $AOC_ROOT)
+
+new flags:
+  -root dir                      directory containing the year/day directories (default from $AOC_ROOT)
+  -force                         regenerate the Go files of an existing day, keeping its answers and examples
+  -templates dir                 directory of *.tmpl templates overriding or completing the default ones
+  -title title                   puzzle title, instead of reading it from the statement
+  -offline                       do not download the statement nor the input
+
+generate flags:
+  -root dir                      directory containing the year/day directories (default from $AOC_ROOT)
+  -templates dir                 directory of *.tmpl templates overriding or completing the default ones`
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	if len(args) == 0 {
+		return errors.New(usage)
+	}
+
+	switch command, args := args[0], args[1:]; command {
+	case "run":
+		inputs := utils.NewInputs()
+		flags := flag.NewFlagSet("run", flag.ContinueOnError)
+		inputs.RegisterFlags(flags)
+		options := aoc.Options{}
+		flags.IntVar(&options.Workers, "j", runtime.NumCPU(), "number of puzzles solved at the same time")
+		flags.DurationVar(&options.Timeout, "timeout", aoc.DefaultTimeout, "abandon a puzzle running longer than this")
+		flags.DurationVar(&options.Budget, "budget", aoc.DefaultBudget, "flag a puzzle running longer than this")
+		if err := flags.Parse(args); err != nil {
+			return err
+		}
+		if flags.NArg() != 1 {
+			return errors.New(usage)
+		}
+		solvers, err := aoc.Select(flags.Arg(0))
+		if err != nil {
+			return err
+		}
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+		return aoc.RunAll(ctx, os.Stdout, solvers, inputs, options)
+
+	case "bench":
+		inputs := utils.NewInputs()
+		flags := flag.NewFlagSet("bench", flag.ContinueOnError)
+		inputs.RegisterFlags(flags)
+		n := flags.Int("n", 10, "number of iterations of each step")
+		save := flags.String("save", "", "save the results as JSON")
+		baselinePath := flags.String("baseline", "", "compare the results to previously saved ones")
+		threshold := flags.Float64("threshold", 0.2, "median slowdown ratio above which a step is a regression")
+		if err := flags.Parse(args); err != nil {
+			return err
+		}
+		if flags.NArg() != 1 || *n < 1 {
+			return errors.New(usage)
+		}
+		solvers, err := aoc.Select(flags.Arg(0))
+		if err != nil {
+			return err
+		}
+		return bench(solvers, inputs, *n, *save, *baselinePath, *threshold)
+
+	case "fetch":
+		inputs := utils.NewInputs()
+		flags := flag.NewFlagSet("fetch", flag.ContinueOnError)
+		inputs.RegisterFlags(flags)
+		refresh := flags.Bool("refresh", false, "download the statement again")
+		force := flags.Bool("force", false, "overwrite the examples and answers already recorded")
+		if err := flags.Parse(args); err != nil {
+			return err
+		}
+		if flags.NArg() != 1 {
+			return errors.New(usage)
+		}
+		id, err := aoc.ParseID(flags.Arg(0))
+		if err != nil {
+			return err
+		}
+		return fetch(id, inputs.Root, *refresh, *force)
+
+	case "submit":
+		inputs := utils.NewInputs()
+		inputs.Source = utils.SourceInput
+		flags := flag.NewFlagSet("submit", flag.ContinueOnError)
+		inputs.RegisterFlags(flags)
+		if err := flags.Parse(args); err != nil {
+			return err
+		}
+		if flags.NArg() != 2 {
+			return errors.New(usage)
+		}
+		solvers, err := aoc.Select(flags.Arg(0))
+		if err != nil {
+			return err
+		}
+		part, err := strconv.Atoi(flags.Arg(1))
+		if len(solvers) != 1 || err != nil || part < 1 || part > 2 {
+			return errors.New(usage)
+		}
+		return submit(solvers[0], inputs, part)
+
+	case "new":
+		inputs := utils.NewInputs()
+		flags := flag.NewFlagSet("new", flag.ContinueOnError)
+		inputs.RegisterFlags(flags)
+		scaffolder := scaffold.Scaffolder{}
+		flags.BoolVar(&scaffolder.Force, "force", false, "regenerate the Go files of an existing day, keeping its answers and examples")
+		flags.StringVar(&scaffolder.Templates, "templates", "", "directory of templates overriding the default ones")
+		title := flags.String("title", "", "puzzle title")
+		offline := flags.Bool("offline", false, "do not download the statement nor the input")
+		if err := flags.Parse(args); err != nil {
+			return err
+		}
+		if flags.NArg() != 1 {
+			return errors.New(usage)
+		}
+		id, err := aoc.ParseID(flags.Arg(0))
+		if err != nil {
+			return err
+		}
+		scaffolder.Root = inputs.Root
+		return newDay(id, scaffolder, *title, *offline)
+
+	case "generate":
+		inputs := utils.NewInputs()
+		flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+		inputs.RegisterFlags(flags)
+		scaffolder := scaffold.Scaffolder{}
+		flags.StringVar(&scaffolder.Templates, "templates", "", "directory of templates overriding the default ones")
+		if err := flags.Parse(args); err != nil {
+			return err
+		}
+		if flags.NArg() != 0 {
+			return errors.New(usage)
+		}
+		scaffolder.Root = inputs.Root
+		return generate(scaffolder)
+
+	case "list":
+		pattern := "all"
+		if len(args) > 0 {
+			pattern = args[0]
+		}
+		solvers, err := aoc.Select(pattern)
+		if err != nil {
+			return err
+		}
+		for _, solver := range solvers {
+			fmt.Printf("%v  %s\n", solver.ID(), solver.Title())
+		}
+		return nil
+
+	case "help", "-h", "--help":
+		fmt.Println(usage)
+		return nil
+
+	default:
+		return fmt.Errorf("unknown command %q\n%s", command, usage)
+	}
+}
+
+func bench(solvers []aoc.Solver, inputs *utils.Inputs, n int, save, baselinePath string, threshold float64) error {
+	baselines := make(map[string]aoc.Benchmark)
+	if baselinePath != "" {
+		benchmarks, err := aoc.ReadBenchmarks(baselinePath)
+		if err != nil {
+			return err
+		}
+		for _, benchmark := range benchmarks {
+			baselines[benchmark.ID] = benchmark
+		}
+	}
+
+	benchmarks := make([]aoc.Benchmark, 0, len(solvers))
+	regressions := make([]string, 0)
+	errs := make([]error, 0)
+	for _, solver := range solvers {
+		// a failing day is reported at the end, without preventing the others from being measured
+		benchmark, err := aoc.Bench(solver, inputs, n)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		if len(benchmark.Steps) == 0 {
+			continue
+		}
+		benchmarks = append(benchmarks, benchmark)
+
+		var baseline *aoc.Benchmark
+		if b, found := baselines[benchmark.ID]; found {
+			baseline = &b
+		}
+		regressions = append(regressions, aoc.PrintBenchmark(os.Stdout, benchmark, baseline, threshold)...)
+	}
+
+	if save != "" {
+		if err := aoc.WriteBenchmarks(save, benchmarks); err != nil {
+			return err
+		}
+	}
+	if len(regressions) > 0 {
+		errs = append(errs, fmt.Errorf("%d regression(s): %s", len(regressions), strings.Join(regressions, ", ")))
+	}
+	return errors.Join(errs...)
+}
+
+func fetch(id aoc.ID, root string, refresh, force bool) error {
+	session, err := client.LoadSession()
+	if err != nil {
+		return err
+	}
+	c := client.New(root, session)
+	ctx := context.Background()
+
+	page, err := c.Puzzle(ctx, id.Year, id.Day, refresh)
+	if err != nil {
+		return err
+	}
+	fmt.Println("statement saved in", filepath.Join(c.Dir(id.Year, id.Day), client.PuzzleFile))
+	paths, err := statement.Parse(page).Save(c.Dir(id.Year, id.Day), force)
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		fmt.Println("extracted", path)
+	}
+
+	if _, err := c.Input(ctx, id.Year, id.Day); err != nil {
+		return err
+	}
+	fmt.Println("input saved in", filepath.Join(c.Dir(id.Year, id.Day), client.InputFile))
+	return nil
+}
+
+func submit(solver aoc.Solver, inputs *utils.Inputs, part int) error {
+	id := solver.ID()
+	name, lines, err := inputs.Read(id.Year, id.Day, part)
+	if err != nil {
+		return err
+	}
+	answer, ok := solver.Solve(part, solver.Parse(aoc.Input{Name: name, Lines: lines}))
+	if !ok {
+		return fmt.Errorf("%v has no part %d", id, part)
+	}
+	fmt.Printf("%v part %d: submitting %v\n", id, part, answer)
+
+	session, err := client.LoadSession()
+	if err != nil {
+		return err
+	}
+	verdict, err := client.New(inputs.Root, session).Submit(context.Background(), id.Year, id.Day, part, fmt.Sprint(answer))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%v: %s\n", verdict.Outcome, verdict.Message)
+	if verdict.Outcome != client.Correct {
+		return fmt.Errorf("%v part %d: %v", id, part, verdict.Outcome)
+	}
+
+	// the right answer becomes the expected one
+	dir := inputs.Dir(id.Year, id.Day)
+	answers, err := aoc.ReadAnswers(dir)
+	if err != nil {
+		return err
+	}
+	if _, found := answers.Expected(name, part); !found {
+		if answers[name] == nil {
+			answers[name] = make(map[string]*string)
+		}
+		expected := fmt.Sprint(answer)
+		answers[name][fmt.Sprintf("part%d", part)] = &expected
+		return aoc.WriteAnswers(dir, answers)
+	}
+	return nil
+}
+
+func newDay(id aoc.ID, scaffolder scaffold.Scaffolder, title string, offline bool) error {
+	ctx := context.Background()
+
+	// retrieve the title and the examples from the statement, a failure is not blocking as they can be set later
+	// offline, the network is never used, so there is no need for a session
+	var c *client.Client
+	var puzzle *statement.Statement
+	if !offline {
+		session, err := client.LoadSession()
+		if err != nil {
+			return err
+		}
+		c = client.New(scaffolder.Root, session)
+
+		if page, err := c.Puzzle(ctx, id.Year, id.Day, false); err != nil {
+			fmt.Fprintln(os.Stderr, "warning: no statement:", err)
+		} else {
+			parsed := statement.Parse(page)
+			puzzle = &parsed
+		}
+	}
+	if title == "" && puzzle != nil {
+		title = puzzle.Title
+	}
+	if title == "" {
+		// a placeholder, to be replaced in the solution once the statement is known
+		title = fmt.Sprintf("Day %d", id.Day)
+		fmt.Fprintf(os.Stderr, "warning: unknown title, using %q\n", title)
+	}
+
+	paths, err := scaffolder.Generate(scaffold.NewData(id.Year, id.Day, title))
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		fmt.Println("created", path)
+	}
+	if puzzle != nil {
+		paths, err := puzzle.Save(scaffolder.Dir(id.Year, id.Day), false)
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			fmt.Println("extracted", path)
+		}
+	}
+
+	// register the day into the aoc command, when scaffolding into this repository
+	if _, err := os.Stat(filepath.Join(scaffolder.Root, scaffold.DaysFile)); err == nil {
+		if err := registerDays(scaffolder.Root); err != nil {
+			return err
+		}
+		fmt.Println("registered", id, "in", filepath.Join(scaffolder.Root, scaffold.DaysFile))
+	}
+
+	fmt.Printf("%v ready (%s)\n", id, title)
+	if offline {
+		return nil
+	}
+	if c.Session == "" {
+		fmt.Println("skip input retrieval, session is not set")
+	} else if _, err := c.Input(ctx, id.Year, id.Day); err != nil {
+		fmt.Fprintln(os.Stderr, "warning: no input:", err)
+	} else {
+		fmt.Println("input saved in", filepath.Join(c.Dir(id.Year, id.Day), client.InputFile))
+	}
+	return nil
+}
+
+// registerDays regenerates the file importing every day package into the aoc command
+func registerDays(root string) error {
+	module, err := scaffold.ReadModule(root)
+	if err != nil {
+		return err
+	}
+	return scaffold.GenerateDays(root, module)
+}
+
+func generate(scaffolder scaffold.Scaffolder) error {
+	if err := registerDays(scaffolder.Root); err != nil {
+		return err
+	}
+	fmt.Println("generated", filepath.Join(scaffolder.Root, scaffold.DaysFile))
+
+	paths, err := scaffolder.GenerateTests()
+	fmt.Printf("generated %d %s files\n", len(paths), scaffold.TestFile)
+	return err
+}
@@ -0,0 +1,282 @@
+package utils
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+const wordSize = 64
+
+// Bitset implements a set of non-negative integers, packed as bits in 64 bits words
+// it grows as needed, so it has no size limit, unlike int masks
+type Bitset struct {
+	words []uint64
+}
+
+// NewBitset is a quick way to get a Bitset of the list of bits
+func NewBitset(bits ...int) Bitset {
+	set := Bitset{}
+	for _, bit := range bits {
+		set.Set(bit)
+	}
+	return set
+}
+
+// Set sets the bit i, growing the set if needed
+// it panics if the bit is negative, as a negative index is a bug of the caller
+func (set *Bitset) Set(i int) {
+	if i < 0 {
+		panic(fmt.Sprintf("Bitset.Set(%d): negative bit", i))
+	}
+	if word := i / wordSize; word >= len(set.words) {
+		set.words = append(set.words, make([]uint64, word+1-len(set.words))...)
+	}
+	set.words[i/wordSize] |= 1 << (i % wordSize)
+}
+
+// Clear clears the bit i, doing nothing if it is outside the set
+func (set *Bitset) Clear(i int) {
+	if i >= 0 && i/wordSize < len(set.words) {
+		set.words[i/wordSize] &^= 1 << (i % wordSize)
+	}
+}
+
+// Test tells whether the bit i is set, negative bits never being set
+func (set Bitset) Test(i int) bool {
+	return i >= 0 && i/wordSize < len(set.words) && set.words[i/wordSize]&(1<<(i%wordSize)) != 0
+}
+
+// Count returns the number of bits set
+func (set Bitset) Count() (count int) {
+	for _, word := range set.words {
+		count += bits.OnesCount64(word)
+	}
+	return count
+}
+
+// IsEmpty tells whether no bit is set
+func (set Bitset) IsEmpty() bool {
+	return set.Count() == 0
+}
+
+// Each calls the callback function for every bit set, in ascending order
+func (set Bitset) Each(callback func(int)) {
+	eachBit(set.words, callback)
+}
+
+// Bits returns the bits set, in ascending order
+func (set Bitset) Bits() []int {
+	return collectBits(set.Each)
+}
+
+// Clone returns a copy of the set, not sharing its storage
+func (set Bitset) Clone() Bitset {
+	return Bitset{words: append([]uint64(nil), set.words...)}
+}
+
+// combine returns a new set whose words are the operation of the words of both sets, missing words being 0
+func (set Bitset) combine(other Bitset, operation func(a, b uint64) uint64) Bitset {
+	result := Bitset{words: make([]uint64, max(len(set.words), len(other.words)))}
+	for i := range result.words {
+		var a, b uint64
+		if i < len(set.words) {
+			a = set.words[i]
+		}
+		if i < len(other.words) {
+			b = other.words[i]
+		}
+		result.words[i] = operation(a, b)
+	}
+	return result
+}
+
+// And returns a new set of the bits set in both sets
+func (set Bitset) And(other Bitset) Bitset {
+	return set.combine(other, func(a, b uint64) uint64 { return a & b })
+}
+
+// Or returns a new set of the bits set in any of the sets
+func (set Bitset) Or(other Bitset) Bitset {
+	return set.combine(other, func(a, b uint64) uint64 { return a | b })
+}
+
+// Xor returns a new set of the bits set in only one of the sets
+func (set Bitset) Xor(other Bitset) Bitset {
+	return set.combine(other, func(a, b uint64) uint64 { return a ^ b })
+}
+
+// AndNot returns a new set of the bits set in the set, but not in the other
+func (set Bitset) AndNot(other Bitset) Bitset {
+	return set.combine(other, func(a, b uint64) uint64 { return a &^ b })
+}
+
+// IsSubsetOf tells whether all the bits set in the set are set in the other
+func (set Bitset) IsSubsetOf(other Bitset) bool {
+	return set.AndNot(other).IsEmpty()
+}
+
+// Equal tells whether both sets have the same bits set, whatever their storage size
+func (set Bitset) Equal(other Bitset) bool {
+	return set.Xor(other).IsEmpty()
+}
+
+// Subsets calls the callback function for every subset of the set, including the empty set and the set itself
+// there are 2^Count() subsets, so it is only usable for sets having a few bits set
+func (set Bitset) Subsets(callback func(Bitset)) {
+	eachSubset(set.Bits(), func(subset []int) { callback(NewBitset(subset...)) })
+}
+
+// String returns the bits set, such as {1 5 8}
+func (set Bitset) String() string {
+	return formatBits(set.Bits())
+}
+
+// FixedBitset implements a set of integers in [0, 256), packed as bits in 4 words
+// being an array, it is comparable and can be used as a map key, and it is copied when assigned
+type FixedBitset [4]uint64
+
+// FixedBitsetSize is the number of bits of a FixedBitset
+const FixedBitsetSize = 4 * wordSize
+
+// NewFixedBitset is a quick way to get a FixedBitset of the list of bits
+func NewFixedBitset(bits ...int) (set FixedBitset) {
+	for _, bit := range bits {
+		set.Set(bit)
+	}
+	return set
+}
+
+// Set sets the bit i, that must be lower than FixedBitsetSize
+func (set *FixedBitset) Set(i int) {
+	set[i/wordSize] |= 1 << (i % wordSize)
+}
+
+// Clear clears the bit i, that must be lower than FixedBitsetSize
+func (set *FixedBitset) Clear(i int) {
+	set[i/wordSize] &^= 1 << (i % wordSize)
+}
+
+// Test tells whether the bit i is set
+func (set FixedBitset) Test(i int) bool {
+	return i >= 0 && i < FixedBitsetSize && set[i/wordSize]&(1<<(i%wordSize)) != 0
+}
+
+// With returns a copy of the set, with the bit i set
+func (set FixedBitset) With(i int) FixedBitset {
+	set.Set(i)
+	return set
+}
+
+// Count returns the number of bits set
+func (set FixedBitset) Count() (count int) {
+	for _, word := range set {
+		count += bits.OnesCount64(word)
+	}
+	return count
+}
+
+// IsEmpty tells whether no bit is set
+func (set FixedBitset) IsEmpty() bool {
+	return set == FixedBitset{}
+}
+
+// Each calls the callback function for every bit set, in ascending order
+func (set FixedBitset) Each(callback func(int)) {
+	eachBit(set[:], callback)
+}
+
+// Bits returns the bits set, in ascending order
+func (set FixedBitset) Bits() []int {
+	return collectBits(set.Each)
+}
+
+// And returns the bits set in both sets
+func (set FixedBitset) And(other FixedBitset) FixedBitset {
+	for i := range set {
+		set[i] &= other[i]
+	}
+	return set
+}
+
+// Or returns the bits set in any of the sets
+func (set FixedBitset) Or(other FixedBitset) FixedBitset {
+	for i := range set {
+		set[i] |= other[i]
+	}
+	return set
+}
+
+// Xor returns the bits set in only one of the sets
+func (set FixedBitset) Xor(other FixedBitset) FixedBitset {
+	for i := range set {
+		set[i] ^= other[i]
+	}
+	return set
+}
+
+// AndNot returns the bits set in the set, but not in the other
+func (set FixedBitset) AndNot(other FixedBitset) FixedBitset {
+	for i := range set {
+		set[i] &^= other[i]
+	}
+	return set
+}
+
+// IsSubsetOf tells whether all the bits set in the set are set in the other
+func (set FixedBitset) IsSubsetOf(other FixedBitset) bool {
+	return set.AndNot(other).IsEmpty()
+}
+
+// Subsets calls the callback function for every subset of the set, including the empty set and the set itself
+// there are 2^Count() subsets, so it is only usable for sets having a few bits set
+func (set FixedBitset) Subsets(callback func(FixedBitset)) {
+	eachSubset(set.Bits(), func(subset []int) { callback(NewFixedBitset(subset...)) })
+}
+
+// String returns the bits set, such as {1 5 8}
+func (set FixedBitset) String() string {
+	return formatBits(set.Bits())
+}
+
+// eachBit calls the callback function for every bit set in the words, in ascending order
+func eachBit(words []uint64, callback func(int)) {
+	for i, word := range words {
+		for word != 0 {
+			bit := bits.TrailingZeros64(word)
+			callback(i*wordSize + bit)
+			word &= word - 1 // clear the lowest bit set
+		}
+	}
+}
+
+// collectBits returns the bits given by the iteration function
+func collectBits(each func(func(int))) []int {
+	indexes := []int{}
+	each(func(bit int) { indexes = append(indexes, bit) })
+	return indexes
+}
+
+// eachSubset calls the callback function for every subset of the bits, the subsets being enumerated as a binary counter
+// it panics with 64 bits or more, whose subsets cannot be counted in 64 bits, and could not be enumerated anyway
+func eachSubset(indexes []int, callback func([]int)) {
+	if len(indexes) >= wordSize {
+		panic(fmt.Sprintf("Subsets of %d bits: too many subsets to enumerate", len(indexes)))
+	}
+	subset := make([]int, 0, len(indexes))
+	for counter := uint64(0); counter < 1<<len(indexes); counter++ {
+		subset = subset[:0]
+		for i, bit := range indexes {
+			if counter&(1<<i) != 0 {
+				subset = append(subset, bit)
+			}
+		}
+		callback(subset)
+	}
+}
+
+// formatBits returns the bits formatted as a set
+func formatBits(indexes []int) string {
+	return "{" + strings.Join(ArrayMap(indexes, strconv.Itoa), " ") + "}"
+}
@@ -0,0 +1,103 @@
+package utils
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBitset(t *testing.T) {
+	a, b := NewBitset(1, 64, 200), NewBitset(1, 3)
+	if !a.Test(200) || a.Test(199) || a.Test(1000) || a.Count() != 3 || a.String() != "{1 64 200}" {
+		t.Errorf("NewBitset(1, 64, 200) = %v", a)
+	}
+	// bits outside the set are never set, like the ones of FixedBitset
+	if a.Test(-1) || a.Test(-64) || NewFixedBitset(0).Test(-1) {
+		t.Errorf("Test() of a negative bit is true")
+	}
+	empty := NewBitset()
+	empty.Clear(-1)
+	empty.Clear(1000)
+	if !empty.IsEmpty() || empty.Test(1000) {
+		t.Errorf("Clear() outside the set = %v", empty)
+	}
+
+	tests := []struct {
+		name     string
+		set      Bitset
+		expected []int
+	}{
+		{"and", a.And(b), []int{1}},
+		{"or", a.Or(b), []int{1, 3, 64, 200}},
+		{"xor", a.Xor(b), []int{3, 64, 200}},
+		{"and not", a.AndNot(b), []int{64, 200}},
+	}
+	for _, test := range tests {
+		if bits := test.set.Bits(); !slices.Equal(bits, test.expected) {
+			t.Errorf("%s = %v, want %v", test.name, bits, test.expected)
+		}
+	}
+
+	clone := a.Clone()
+	clone.Clear(64)
+	if !a.Test(64) || clone.Test(64) || !clone.IsSubsetOf(a) || a.IsSubsetOf(clone) || !NewBitset(1).Equal(a.And(b)) {
+		t.Errorf("Clone, IsSubsetOf or Equal failed")
+	}
+
+	subsets := []string{}
+	b.Subsets(func(subset Bitset) { subsets = append(subsets, subset.String()) })
+	if !slices.Equal(subsets, []string{"{}", "{1}", "{3}", "{1 3}"}) {
+		t.Errorf("Subsets() = %v", subsets)
+	}
+}
+
+func TestBitsetPanics(t *testing.T) {
+	all := NewBitset()
+	for i := 0; i < 64; i++ {
+		all.Set(i)
+	}
+	tests := []struct {
+		name  string
+		call  func()
+		panic string
+	}{
+		{"Set(-1)", func() { all.Set(-1) }, "Bitset.Set(-1): negative bit"},
+		{"Subsets() of 64 bits", func() { all.Subsets(func(Bitset) {}) }, "Subsets of 64 bits: too many subsets to enumerate"},
+		{"Subsets() of 64 fixed bits", func() { NewFixedBitset(all.Bits()...).Subsets(func(FixedBitset) {}) }, "Subsets of 64 bits: too many subsets to enumerate"},
+	}
+	for _, test := range tests {
+		func() {
+			defer func() {
+				if r := recover(); r != test.panic {
+					t.Errorf("%s panic = %v, want %q", test.name, r, test.panic)
+				}
+			}()
+			test.call()
+		}()
+	}
+}
+
+func TestFixedBitset(t *testing.T) {
+	a, b := NewFixedBitset(0, 63, 255), NewFixedBitset(63, 100)
+	seen := map[FixedBitset]bool{a: true}
+	if !seen[NewFixedBitset(255, 63, 0)] || seen[b] {
+		t.Errorf("FixedBitset is not usable as a map key")
+	}
+
+	if bits := a.Or(b).AndNot(a.And(b)).Bits(); !slices.Equal(bits, a.Xor(b).Bits()) || !slices.Equal(bits, []int{0, 100, 255}) {
+		t.Errorf("symmetric difference = %v", bits)
+	}
+	if c := a.With(5); !a.IsSubsetOf(c) || c.Count() != 4 || a.Test(5) {
+		t.Errorf("With(5) = %v, modified %v", c, a)
+	}
+
+	count := 0
+	a.Subsets(func(subset FixedBitset) {
+		if !subset.IsSubsetOf(a) {
+			t.Errorf("subset %v is not a subset of %v", subset, a)
+		}
+		count++
+	})
+	if count != 8 {
+		t.Errorf("Subsets() enumerated %d subsets, want 8", count)
+	}
+}
@@ -0,0 +1,138 @@
+package collections
+
+// Deque implements a double-ended queue, backed by a growable ring buffer
+// pushes and pops at both ends are amortised O(1), and popped slots are reused by later pushes
+type Deque[T any] struct {
+	elems []T // ring buffer, its length being the capacity
+	head  int // index of the front element
+	size  int
+}
+
+// NewDeque creates a new double-ended queue containing the list of elements, from front to back
+func NewDeque[T any](elems ...T) *Deque[T] {
+	deque := &Deque[T]{}
+	deque.PushBack(elems...)
+	return deque
+}
+
+// index returns the index in the ring buffer of the i-th element from the front
+func (deque *Deque[T]) index(i int) int {
+	i += deque.head
+	if i >= len(deque.elems) {
+		i -= len(deque.elems)
+	}
+	return i
+}
+
+// Reserve grows the capacity, if needed, so that n more elements can be pushed without reallocation
+func (deque *Deque[T]) Reserve(n int) {
+	if deque.size+n <= len(deque.elems) {
+		return
+	}
+	elems := make([]T, max(deque.size+n, 2*len(deque.elems), 8))
+	deque.copyTo(elems)
+	deque.elems, deque.head = elems, 0
+}
+
+// copyTo copies the elements, from front to back, at the start of the slice
+func (deque *Deque[T]) copyTo(elems []T) {
+	n := copy(elems, deque.elems[deque.head:min(deque.head+deque.size, len(deque.elems))])
+	copy(elems[n:], deque.elems[:deque.size-n])
+}
+
+// PushBack insert elements at the back, in order
+func (deque *Deque[T]) PushBack(elems ...T) {
+	deque.Reserve(len(elems))
+	for _, elem := range elems {
+		deque.elems[deque.index(deque.size)] = elem
+		deque.size++
+	}
+}
+
+// PushFront insert elements at the front, in order, so that the last one ends up in front
+func (deque *Deque[T]) PushFront(elems ...T) {
+	deque.Reserve(len(elems))
+	for _, elem := range elems {
+		deque.head = deque.index(len(deque.elems) - 1)
+		deque.elems[deque.head] = elem
+		deque.size++
+	}
+}
+
+// Front access the front element, and return whether it has been found or not
+func (deque *Deque[T]) Front() (elem T, found bool) {
+	if deque.IsEmpty() {
+		return elem, false
+	}
+	return deque.elems[deque.head], true
+}
+
+// Back access the back element, and return whether it has been found or not
+func (deque *Deque[T]) Back() (elem T, found bool) {
+	if deque.IsEmpty() {
+		return elem, false
+	}
+	return deque.elems[deque.index(deque.size-1)], true
+}
+
+// PopFront returns and removes the front element, and return whether it has been found or not
+func (deque *Deque[T]) PopFront() (elem T, found bool) {
+	if deque.IsEmpty() {
+		return elem, false
+	}
+	var zero T
+	elem, deque.elems[deque.head] = deque.elems[deque.head], zero // release the reference held by the slot
+	deque.head = deque.index(1)
+	deque.size--
+	return elem, true
+}
+
+// PopBack returns and removes the back element, and return whether it has been found or not
+func (deque *Deque[T]) PopBack() (elem T, found bool) {
+	if deque.IsEmpty() {
+		return elem, false
+	}
+	var zero T
+	i := deque.index(deque.size - 1)
+	elem, deque.elems[i] = deque.elems[i], zero // release the reference held by the slot
+	deque.size--
+	return elem, true
+}
+
+// At returns the i-th element from the front, that must exist
+func (deque *Deque[T]) At(i int) T {
+	if i < 0 || i >= deque.size {
+		panic("collections: deque index out of range")
+	}
+	return deque.elems[deque.index(i)]
+}
+
+// Each calls the callback function for every element, from front to back
+func (deque *Deque[T]) Each(callback func(int, T)) {
+	for i := 0; i < deque.size; i++ {
+		callback(i, deque.elems[deque.index(i)])
+	}
+}
+
+// Slice returns a copy of the elements, from front to back
+func (deque *Deque[T]) Slice() []T {
+	elems := make([]T, deque.size)
+	deque.copyTo(elems)
+	return elems
+}
+
+// Len returns the number of elements
+func (deque *Deque[T]) Len() int {
+	return deque.size
+}
+
+// Clear removes all the elements, keeping the capacity for later pushes
+func (deque *Deque[T]) Clear() {
+	clear(deque.elems)
+	deque.head, deque.size = 0, 0
+}
+
+// IsEmpty checks whether the underlying container is empty
+func (deque *Deque[T]) IsEmpty() bool {
+	return deque.size == 0
+}
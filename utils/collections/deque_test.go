@@ -0,0 +1,60 @@
+package collections
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDeque(t *testing.T) {
+	deque := NewDeque(3, 4)
+	deque.PushFront(2, 1)
+	deque.PushBack(5)
+	if got := deque.Slice(); !slices.Equal(got, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("Slice() = %v, want [1 2 3 4 5]", got)
+	}
+
+	// wrap around the ring buffer many times, comparing with a plain slice
+	expected := deque.Slice()
+	for i := 0; i < 1000; i++ {
+		switch i % 5 {
+		case 0, 1:
+			deque.PushBack(i)
+			expected = append(expected, i)
+		case 2:
+			deque.PushFront(i)
+			expected = append([]int{i}, expected...)
+		case 3:
+			elem, _ := deque.PopFront()
+			if elem != expected[0] {
+				t.Fatalf("PopFront() = %d, want %d", elem, expected[0])
+			}
+			expected = expected[1:]
+		case 4:
+			elem, _ := deque.PopBack()
+			if elem != expected[len(expected)-1] {
+				t.Fatalf("PopBack() = %d, want %d", elem, expected[len(expected)-1])
+			}
+			expected = expected[:len(expected)-1]
+		}
+	}
+	iterated := []int{}
+	deque.Each(func(i int, elem int) {
+		if elem != deque.At(i) {
+			t.Errorf("Each() element %d = %d, At() = %d", i, elem, deque.At(i))
+		}
+		iterated = append(iterated, elem)
+	})
+	if !slices.Equal(iterated, expected) || deque.Len() != len(expected) {
+		t.Errorf("Each() = %v, want %v", iterated, expected)
+	}
+
+	capacity := len(deque.elems)
+	deque.Clear()
+	if _, found := deque.PopBack(); found || !deque.IsEmpty() || len(deque.elems) != capacity {
+		t.Errorf("Clear() left elements or released the capacity")
+	}
+	deque.Reserve(capacity + 1)
+	if len(deque.elems) < capacity+1 {
+		t.Errorf("Reserve(%d) capacity = %d", capacity+1, len(deque.elems))
+	}
+}
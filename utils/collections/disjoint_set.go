@@ -0,0 +1,120 @@
+package collections
+
+// DisjointSet implements a union-find structure, partitioning elements into connected components
+// it uses path compression and union by rank, so that operations run in nearly constant amortised time
+type DisjointSet[T comparable] struct {
+	index  map[T]int // position of each element in the slices below
+	elems  []T
+	parent []int
+	rank   []int
+	size   []int // size of the component, only meaningful for roots
+	count  int   // number of components
+}
+
+// NewDisjointSet creates a new disjoint set, each element of the list being its own component
+func NewDisjointSet[T comparable](elems ...T) *DisjointSet[T] {
+	set := &DisjointSet[T]{index: make(map[T]int, len(elems))}
+	set.Add(elems...)
+	return set
+}
+
+// Add inserts the elements not already present, each one being its own component
+func (set *DisjointSet[T]) Add(elems ...T) {
+	for _, elem := range elems {
+		set.find(elem)
+	}
+}
+
+// find returns the position of the root of the element, adding the element if needed
+func (set *DisjointSet[T]) find(elem T) int {
+	i, found := set.index[elem]
+	if !found {
+		i = len(set.elems)
+		set.index[elem] = i
+		set.elems = append(set.elems, elem)
+		set.parent = append(set.parent, i)
+		set.rank = append(set.rank, 0)
+		set.size = append(set.size, 1)
+		set.count++
+		return i
+	}
+
+	root := i
+	for set.parent[root] != root {
+		root = set.parent[root]
+	}
+	// path compression: link every element of the path directly to the root
+	for set.parent[i] != root {
+		set.parent[i], i = root, set.parent[i]
+	}
+	return root
+}
+
+// Find returns the root element representing the component of the element, adding the element if needed
+func (set *DisjointSet[T]) Find(elem T) T {
+	return set.elems[set.find(elem)]
+}
+
+// Union merges the components of both elements, adding them if needed, and returns whether they were disjoint
+func (set *DisjointSet[T]) Union(a, b T) bool {
+	rootA, rootB := set.find(a), set.find(b)
+	if rootA == rootB {
+		return false
+	}
+
+	// union by rank: attach the shallowest tree under the deepest one
+	if set.rank[rootA] < set.rank[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+	set.parent[rootB] = rootA
+	set.size[rootA] += set.size[rootB]
+	if set.rank[rootA] == set.rank[rootB] {
+		set.rank[rootA]++
+	}
+	set.count--
+	return true
+}
+
+// Connected tells whether both elements are in the same component
+func (set *DisjointSet[T]) Connected(a, b T) bool {
+	return set.find(a) == set.find(b)
+}
+
+// Size returns the number of elements in the component of the element
+func (set *DisjointSet[T]) Size(elem T) int {
+	return set.size[set.find(elem)]
+}
+
+// Len returns the number of elements
+func (set *DisjointSet[T]) Len() int {
+	return len(set.elems)
+}
+
+// Count returns the number of components
+func (set *DisjointSet[T]) Count() int {
+	return set.count
+}
+
+// Sizes returns the size of every component, by root element
+func (set *DisjointSet[T]) Sizes() map[T]int {
+	sizes := make(map[T]int, set.count)
+	for i := range set.elems {
+		if set.parent[i] == i {
+			sizes[set.elems[i]] = set.size[i]
+		}
+	}
+	return sizes
+}
+
+// Components returns the elements of every component, by root element
+func (set *DisjointSet[T]) Components() map[T][]T {
+	components := make(map[T][]T, set.count)
+	for i, elem := range set.elems {
+		root := set.elems[set.find(elem)]
+		if components[root] == nil {
+			components[root] = make([]T, 0, set.size[set.index[root]])
+		}
+		components[root] = append(components[root], set.elems[i])
+	}
+	return components
+}
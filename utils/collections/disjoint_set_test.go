@@ -0,0 +1,45 @@
+package collections
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDisjointSet(t *testing.T) {
+	set := NewDisjointSet(1, 2, 3, 4, 5, 6)
+	if set.Count() != 6 || set.Len() != 6 {
+		t.Fatalf("Count() = %d, Len() = %d, want 6 singletons", set.Count(), set.Len())
+	}
+
+	merges := []struct {
+		a, b   int
+		merged bool
+	}{{1, 2, true}, {3, 4, true}, {2, 4, true}, {1, 3, false}, {5, 7, true}}
+	for _, merge := range merges {
+		if merged := set.Union(merge.a, merge.b); merged != merge.merged {
+			t.Errorf("Union(%d, %d) = %v, want %v", merge.a, merge.b, merged, merge.merged)
+		}
+	}
+
+	if set.Count() != 3 || set.Len() != 7 {
+		t.Errorf("Count() = %d, Len() = %d, want 3 components of 7 elements", set.Count(), set.Len())
+	}
+	if !set.Connected(1, 4) || set.Connected(1, 5) || set.Size(3) != 4 || set.Size(6) != 1 {
+		t.Errorf("components are not {1, 2, 3, 4}, {5, 7}, {6}")
+	}
+
+	sizes := set.Sizes()
+	components := set.Components()
+	if len(sizes) != 3 || len(components) != 3 {
+		t.Fatalf("Sizes() = %v, Components() = %v, want 3 components", sizes, components)
+	}
+	for root, members := range components {
+		slices.Sort(members)
+		if set.Find(root) != root || sizes[root] != len(members) || !slices.Contains(members, root) {
+			t.Errorf("component of %d = %v, with size %d", root, members, sizes[root])
+		}
+	}
+	if members := components[set.Find(2)]; !slices.Equal(members, []int{1, 2, 3, 4}) {
+		t.Errorf("component of 2 = %v, want [1 2 3 4]", members)
+	}
+}
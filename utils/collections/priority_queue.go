@@ -0,0 +1,134 @@
+package collections
+
+import "cmp"
+
+// PriorityQueue implements a min-priority queue of keys, backed by a d-ary heap
+// each key is present at most once, so pushing a key again updates its priority (decrease-key)
+type PriorityQueue[K comparable, P cmp.Ordered] struct {
+	arity   int
+	entries []entry[K, P]
+	index   map[K]int // position of each key in entries
+}
+
+type entry[K comparable, P cmp.Ordered] struct {
+	key      K
+	priority P
+}
+
+// NewPriorityQueue creates a new min-priority queue backed by a binary heap
+func NewPriorityQueue[K comparable, P cmp.Ordered]() *PriorityQueue[K, P] {
+	return NewDaryPriorityQueue[K, P](2)
+}
+
+// NewDaryPriorityQueue creates a new min-priority queue backed by a heap whose nodes have arity children
+// a higher arity makes pushes and priority decreases cheaper, and pops more expensive
+func NewDaryPriorityQueue[K comparable, P cmp.Ordered](arity int) *PriorityQueue[K, P] {
+	return &PriorityQueue[K, P]{arity: max(arity, 2), index: make(map[K]int)}
+}
+
+// Push inserts the key with the priority, or updates its priority if the key is already queued
+func (queue *PriorityQueue[K, P]) Push(key K, priority P) {
+	i, found := queue.index[key]
+	if !found {
+		i = len(queue.entries)
+		queue.entries = append(queue.entries, entry[K, P]{key: key, priority: priority})
+		queue.index[key] = i
+		queue.up(i)
+		return
+	}
+
+	previous := queue.entries[i].priority
+	queue.entries[i].priority = priority
+	if priority < previous {
+		queue.up(i)
+	} else {
+		queue.down(i)
+	}
+}
+
+// Decrease lowers the priority of the key, inserting it if needed, and returns whether the queue has been updated
+// it is the relaxation step of Dijkstra algorithm: a key already queued with a lower or equal priority is left untouched
+func (queue *PriorityQueue[K, P]) Decrease(key K, priority P) bool {
+	if i, found := queue.index[key]; found && queue.entries[i].priority <= priority {
+		return false
+	}
+	queue.Push(key, priority)
+	return true
+}
+
+// Peek access the key having the lowest priority, and return whether it has been found or not
+func (queue *PriorityQueue[K, P]) Peek() (key K, priority P, found bool) {
+	if queue.IsEmpty() {
+		return key, priority, false
+	}
+	return queue.entries[0].key, queue.entries[0].priority, true
+}
+
+// Pop returns and removes the key having the lowest priority, and return whether it has been found or not
+func (queue *PriorityQueue[K, P]) Pop() (key K, priority P, found bool) {
+	if queue.IsEmpty() {
+		return key, priority, false
+	}
+	top := queue.entries[0]
+	last := len(queue.entries) - 1
+	queue.swap(0, last)
+	queue.entries = queue.entries[:last]
+	delete(queue.index, top.key)
+	queue.down(0)
+	return top.key, top.priority, true
+}
+
+// Priority returns the priority of the key, and whether it is queued
+func (queue *PriorityQueue[K, P]) Priority(key K) (priority P, found bool) {
+	i, found := queue.index[key]
+	if !found {
+		return priority, false
+	}
+	return queue.entries[i].priority, true
+}
+
+// Len returns the number of queued keys
+func (queue *PriorityQueue[K, P]) Len() int {
+	return len(queue.entries)
+}
+
+// IsEmpty checks whether the underlying container is empty
+func (queue *PriorityQueue[K, P]) IsEmpty() bool {
+	return len(queue.entries) == 0
+}
+
+// up moves the entry i towards the root until its parent has a lower priority
+func (queue *PriorityQueue[K, P]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / queue.arity
+		if queue.entries[parent].priority <= queue.entries[i].priority {
+			return
+		}
+		queue.swap(i, parent)
+		i = parent
+	}
+}
+
+// down moves the entry i towards the leaves until all its children have a higher priority
+func (queue *PriorityQueue[K, P]) down(i int) {
+	for {
+		smallest := i
+		first := i*queue.arity + 1
+		for child := first; child < first+queue.arity && child < len(queue.entries); child++ {
+			if queue.entries[child].priority < queue.entries[smallest].priority {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			return
+		}
+		queue.swap(i, smallest)
+		i = smallest
+	}
+}
+
+func (queue *PriorityQueue[K, P]) swap(i, j int) {
+	queue.entries[i], queue.entries[j] = queue.entries[j], queue.entries[i]
+	queue.index[queue.entries[i].key] = i
+	queue.index[queue.entries[j].key] = j
+}
@@ -0,0 +1,43 @@
+package collections
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	for _, arity := range []int{2, 4} {
+		queue := NewDaryPriorityQueue[int, int](arity)
+		priorities := map[int]int{}
+		for i := 0; i < 1000; i++ {
+			key, priority := rand.Intn(200), rand.Intn(10_000)
+			if i%2 == 0 {
+				queue.Push(key, priority)
+				priorities[key] = priority
+			} else if queue.Decrease(key, priority) {
+				priorities[key] = priority
+			} else if previous := priorities[key]; previous > priority {
+				t.Fatalf("Decrease(%d, %d) ignored, queued with %d", key, priority, previous)
+			}
+		}
+
+		if queue.Len() != len(priorities) {
+			t.Fatalf("Len() = %d, want %d", queue.Len(), len(priorities))
+		}
+		popped := []int{}
+		for !queue.IsEmpty() {
+			key, priority, _ := queue.Pop()
+			if priorities[key] != priority {
+				t.Fatalf("Pop() = %d with %d, want %d", key, priority, priorities[key])
+			}
+			popped = append(popped, priority)
+		}
+		if !slices.IsSorted(popped) {
+			t.Errorf("arity %d: priorities popped out of order: %v", arity, popped)
+		}
+		if _, _, found := queue.Pop(); found {
+			t.Errorf("Pop() found an element in an empty queue")
+		}
+	}
+}
@@ -1,39 +1,53 @@
 package collections
 
-// queue implements basic FIFO container
-type queue[T any] struct {
-	elems []T
+// Queue implements basic FIFO container, backed by a Deque so that dequeued slots are reused
+type Queue[T any] struct {
+	elems Deque[T]
 }
 
 // NewQueue creates a new FIFO container containing the list of elements
-func NewQueue[T any](elems ...T) *queue[T] {
-	return &queue[T]{append([]T{}, elems...)}
+func NewQueue[T any](elems ...T) *Queue[T] {
+	queue := &Queue[T]{}
+	queue.elems.PushBack(elems...)
+	return queue
 }
 
 // Enqueue insert elements at the end
-func (queue *queue[T]) Enqueue(elems ...T) {
-	queue.elems = append(queue.elems, elems...)
+func (queue *Queue[T]) Enqueue(elems ...T) {
+	queue.elems.PushBack(elems...)
 }
 
 // Peek access the top element, and return whether it has been found or not
-func (queue *queue[T]) Peek() (elem T, found bool) {
-	if queue.IsEmpty() {
-		return elem, false
-	}
-	elem = queue.elems[0]
-	return elem, true
+func (queue *Queue[T]) Peek() (elem T, found bool) {
+	return queue.elems.Front()
 }
 
 // Dequeue returns and removes the top element, and return whether it has been found or not
-func (queue *queue[T]) Dequeue() (elem T, found bool) {
-	if queue.IsEmpty() {
-		return elem, false
-	}
-	elem, queue.elems = queue.elems[0], queue.elems[1:]
-	return elem, true
+func (queue *Queue[T]) Dequeue() (elem T, found bool) {
+	return queue.elems.PopFront()
+}
+
+// Each calls the callback function for every element, from top to end
+func (queue *Queue[T]) Each(callback func(int, T)) {
+	queue.elems.Each(callback)
+}
+
+// Reserve grows the capacity, if needed, so that n more elements can be enqueued without reallocation
+func (queue *Queue[T]) Reserve(n int) {
+	queue.elems.Reserve(n)
+}
+
+// Clear removes all the elements, keeping the capacity
+func (queue *Queue[T]) Clear() {
+	queue.elems.Clear()
+}
+
+// Len returns the number of elements
+func (queue *Queue[T]) Len() int {
+	return queue.elems.Len()
 }
 
 // IsEmpty checks whether the underlying container is empty
-func (queue *queue[T]) IsEmpty() bool {
-	return len(queue.elems) == 0
+func (queue *Queue[T]) IsEmpty() bool {
+	return queue.elems.IsEmpty()
 }
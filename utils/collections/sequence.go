@@ -0,0 +1,219 @@
+package collections
+
+// Sequence implements an indexable list backed by an implicit treap (a randomized balanced binary tree ordered by position)
+// inserting, deleting, accessing by index, finding the index of a node and rotating are all O(log n) on average
+type Sequence[T any] struct {
+	root *SequenceNode[T]
+	seed uint64 // state of the pseudo-random generator of the priorities
+}
+
+// SequenceNode holds a value of a Sequence, and is a handle to find its index while the sequence changes
+// a node removed from a sequence can be inserted again, keeping the same handle
+type SequenceNode[T any] struct {
+	Value T
+
+	priority            uint64
+	size                int // number of nodes in the subtree
+	left, right, parent *SequenceNode[T]
+}
+
+// NewSequence creates a new sequence containing the list of values, in order
+func NewSequence[T any](values ...T) *Sequence[T] {
+	sequence := &Sequence[T]{seed: 0x9e3779b97f4a7c15}
+	for _, value := range values {
+		sequence.Insert(sequence.Len(), value)
+	}
+	return sequence
+}
+
+// random returns the next pseudo-random priority (xorshift)
+func (sequence *Sequence[T]) random() uint64 {
+	sequence.seed ^= sequence.seed << 13
+	sequence.seed ^= sequence.seed >> 7
+	sequence.seed ^= sequence.seed << 17
+	return sequence.seed
+}
+
+func size[T any](node *SequenceNode[T]) int {
+	if node == nil {
+		return 0
+	}
+	return node.size
+}
+
+// update recomputes the size of the node, and links its children to it
+func (node *SequenceNode[T]) update() {
+	node.size = 1 + size(node.left) + size(node.right)
+	if node.left != nil {
+		node.left.parent = node
+	}
+	if node.right != nil {
+		node.right.parent = node
+	}
+}
+
+// split splits the tree into the first k nodes and the others
+func split[T any](node *SequenceNode[T], k int) (left, right *SequenceNode[T]) {
+	if node == nil {
+		return nil, nil
+	}
+	if size(node.left) >= k {
+		left, node.left = split(node.left, k)
+		node.update()
+		return left, node
+	}
+	node.right, right = split(node.right, k-size(node.left)-1)
+	node.update()
+	return node, right
+}
+
+// merge concatenates both trees, keeping the node of highest priority at the root
+func merge[T any](left, right *SequenceNode[T]) *SequenceNode[T] {
+	switch {
+	case left == nil:
+		return right
+	case right == nil:
+		return left
+	case left.priority > right.priority:
+		left.right = merge(left.right, right)
+		left.update()
+		return left
+	default:
+		right.left = merge(left, right.left)
+		right.update()
+		return right
+	}
+}
+
+// setRoot sets the root of the tree, detaching it from any former parent
+func (sequence *Sequence[T]) setRoot(root *SequenceNode[T]) {
+	if root != nil {
+		root.parent = nil
+	}
+	sequence.root = root
+}
+
+// Len returns the number of values
+func (sequence *Sequence[T]) Len() int {
+	return size(sequence.root)
+}
+
+// checkIndex panics if the index is not in [0, limit]
+func checkIndex(i, limit int) {
+	if i < 0 || i > limit {
+		panic("collections: sequence index out of range")
+	}
+}
+
+// Node returns the node at the index, that must exist
+func (sequence *Sequence[T]) Node(i int) *SequenceNode[T] {
+	checkIndex(i, sequence.Len()-1)
+	node := sequence.root
+	for {
+		switch left := size(node.left); {
+		case i < left:
+			node = node.left
+		case i > left:
+			i -= left + 1
+			node = node.right
+		default:
+			return node
+		}
+	}
+}
+
+// At returns the value at the index, that must exist
+func (sequence *Sequence[T]) At(i int) T {
+	return sequence.Node(i).Value
+}
+
+// Set sets the value at the index, that must exist
+func (sequence *Sequence[T]) Set(i int, value T) {
+	sequence.Node(i).Value = value
+}
+
+// Insert inserts the value at the index, in [0, Len()], and returns its node
+func (sequence *Sequence[T]) Insert(i int, value T) *SequenceNode[T] {
+	node := &SequenceNode[T]{Value: value}
+	sequence.InsertNode(i, node)
+	return node
+}
+
+// InsertNode inserts a node, new or removed from a sequence, at the index in [0, Len()]
+func (sequence *Sequence[T]) InsertNode(i int, node *SequenceNode[T]) {
+	checkIndex(i, sequence.Len())
+	if node.priority == 0 {
+		node.priority = sequence.random()
+	}
+	node.left, node.right, node.parent = nil, nil, nil
+	node.update()
+
+	left, right := split(sequence.root, i)
+	sequence.setRoot(merge(merge(left, node), right))
+}
+
+// Delete removes the node at the index, that must exist, and returns it
+func (sequence *Sequence[T]) Delete(i int) *SequenceNode[T] {
+	checkIndex(i, sequence.Len()-1)
+	left, right := split(sequence.root, i)
+	node, right := split(right, 1)
+	sequence.setRoot(merge(left, right))
+	node.parent = nil
+	return node
+}
+
+// Remove removes the node, that must be in the sequence, and returns its former index
+func (sequence *Sequence[T]) Remove(node *SequenceNode[T]) int {
+	i := sequence.Index(node)
+	sequence.Delete(i)
+	return i
+}
+
+// Index returns the current index of the node, that must be in the sequence
+func (sequence *Sequence[T]) Index(node *SequenceNode[T]) int {
+	i := size(node.left)
+	for ; node.parent != nil; node = node.parent {
+		if node == node.parent.right {
+			i += size(node.parent.left) + 1
+		}
+	}
+	return i
+}
+
+// Move moves the node, that must be in the sequence, to the index in [0, Len()-1]
+func (sequence *Sequence[T]) Move(node *SequenceNode[T], i int) {
+	sequence.Remove(node)
+	sequence.InsertNode(i, node)
+}
+
+// Rotate rotates the values by k positions to the left (to the right if k is negative), cyclically
+// the value at index k becomes the first one
+func (sequence *Sequence[T]) Rotate(k int) {
+	if n := sequence.Len(); n > 0 {
+		left, right := split(sequence.root, ((k%n)+n)%n)
+		sequence.setRoot(merge(right, left))
+	}
+}
+
+// Each calls the callback function for every value, in order
+func (sequence *Sequence[T]) Each(callback func(int, T)) {
+	i := 0
+	var walk func(*SequenceNode[T])
+	walk = func(node *SequenceNode[T]) {
+		if node == nil {
+			return
+		}
+		walk(node.left)
+		callback(i, node.Value)
+		i++
+		walk(node.right)
+	}
+	walk(sequence.root)
+}
+
+// Slice returns a copy of the values, in order
+func (sequence *Sequence[T]) Slice() []T {
+	values := make([]T, 0, sequence.Len())
+	sequence.Each(func(_ int, value T) { values = append(values, value) })
+	return values
+}
@@ -0,0 +1,60 @@
+package collections
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestSequence(t *testing.T) {
+	sequence := NewSequence(0, 1, 2)
+	expected := []int{0, 1, 2}
+	nodes := map[int]*SequenceNode[int]{}
+	for i := 0; i < 3; i++ {
+		nodes[i] = sequence.Node(i)
+	}
+
+	// compare random operations with a plain slice
+	for value := 3; value < 2000; value++ {
+		switch i := rand.Intn(len(expected) + 1); value % 4 {
+		case 0, 1:
+			nodes[value] = sequence.Insert(i, value)
+			expected = slices.Insert(expected, i, value)
+		case 2:
+			if i == len(expected) {
+				i--
+			}
+			node := sequence.Delete(i)
+			if node.Value != expected[i] {
+				t.Fatalf("Delete(%d) = %d, want %d", i, node.Value, expected[i])
+			}
+			delete(nodes, node.Value)
+			expected = slices.Delete(expected, i, i+1)
+		case 3:
+			moved := expected[rand.Intn(len(expected))]
+			to := rand.Intn(len(expected))
+			sequence.Move(nodes[moved], to)
+			expected = slices.Insert(slices.DeleteFunc(expected, func(v int) bool { return v == moved }), to, moved)
+		}
+	}
+
+	if values := sequence.Slice(); !slices.Equal(values, expected) || sequence.Len() != len(expected) {
+		t.Fatalf("Slice() differs from the expected values")
+	}
+	for i, value := range expected {
+		if index := sequence.Index(nodes[value]); index != i || sequence.At(i) != value {
+			t.Fatalf("Index(%d) = %d, At(%d) = %d, want %d", value, index, i, sequence.At(i), i)
+		}
+	}
+
+	sequence.Rotate(-3)
+	expected = append(expected[len(expected)-3:], expected[:len(expected)-3]...)
+	if values := sequence.Slice(); !slices.Equal(values, expected) {
+		t.Errorf("Rotate(-3) = %v..., want %v...", values[:5], expected[:5])
+	}
+	sequence.Rotate(len(expected) + 5)
+	expected = append(expected[5:], expected[:5]...)
+	if values := sequence.Slice(); !slices.Equal(values, expected) || sequence.Index(nodes[expected[0]]) != 0 {
+		t.Errorf("Rotate(n+5) = %v..., want %v...", values[:5], expected[:5])
+	}
+}
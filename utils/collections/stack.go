@@ -1,22 +1,22 @@
 package collections
 
-// stack implements basic LIFO container
-type stack[T any] struct {
+// Stack implements basic LIFO container
+type Stack[T any] struct {
 	elems []T
 }
 
 // NewStack creates a new LIFO container containing the list of elements
-func NewStack[T any](elems ...T) *stack[T] {
-	return &stack[T]{append([]T{}, elems...)}
+func NewStack[T any](elems ...T) *Stack[T] {
+	return &Stack[T]{append([]T{}, elems...)}
 }
 
 // Push insert elements at the end
-func (stack *stack[T]) Push(elems ...T) {
+func (stack *Stack[T]) Push(elems ...T) {
 	stack.elems = append(stack.elems, elems...)
 }
 
 // Peek access the top element, and return whether it has been found or not
-func (stack *stack[T]) Peek() (elem T, found bool) {
+func (stack *Stack[T]) Peek() (elem T, found bool) {
 	l := len(stack.elems)
 	if l == 0 {
 		return elem, false
@@ -26,7 +26,7 @@ func (stack *stack[T]) Peek() (elem T, found bool) {
 }
 
 // Pop returns and removes the top element, and return whether it has been found or not
-func (stack *stack[T]) Pop() (elem T, found bool) {
+func (stack *Stack[T]) Pop() (elem T, found bool) {
 	l := len(stack.elems)
 	if l == 0 {
 		return elem, false
@@ -36,6 +36,17 @@ func (stack *stack[T]) Pop() (elem T, found bool) {
 }
 
 // IsEmpty checks whether the underlying container is empty
-func (stack *stack[T]) IsEmpty() bool {
+func (stack *Stack[T]) IsEmpty() bool {
 	return len(stack.elems) == 0
 }
+
+// Len returns the number of elements
+func (stack *Stack[T]) Len() int {
+	return len(stack.elems)
+}
+
+// Clear removes all the elements, keeping the capacity
+func (stack *Stack[T]) Clear() {
+	clear(stack.elems)
+	stack.elems = stack.elems[:0]
+}
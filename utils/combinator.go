@@ -0,0 +1,262 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Parser parses a value from the text starting at position, and returns it along with the position following it
+// parsers are built by combining the basic ones (Literal, Int, Identifier, OneOf) with Seq2, Or, SeparatedBy, Recursive...
+//
+//	// a list of integers and lists, such as [1,[2,3],[]]
+//	list := Recursive(func(list Parser[any]) Parser[any] {
+//		item := Or(Map(Int(), func(i int) any { return i }), list)
+//		return Map(Between("[", SeparatedBy(item, ","), "]"), func(items []any) any { return items })
+//	})
+type Parser[T any] func(text string, position int) (value T, next int, err error)
+
+// Parse parses the whole text, it is an error to have text left after the value
+// errors are ScanError located at the column where parsing failed
+func (parser Parser[T]) Parse(text string) (T, error) {
+	value, position, err := parser(text, 0)
+	if err == nil && position < len(text) {
+		err = expected(text, position, "the end of the line")
+	}
+	return value, err
+}
+
+// ParseLines parses each line with the parser, errors are located at the line and column where parsing failed
+func ParseLines[T any](parser Parser[T], lines []string) ([]T, error) {
+	values := make([]T, len(lines))
+	for i, line := range lines {
+		var err error
+		if values[i], err = parser.Parse(line); err != nil {
+			if located := (*ScanError)(nil); errors.As(err, &located) {
+				located.Line = i + 1
+			}
+			return values, err
+		}
+	}
+	return values, nil
+}
+
+// expectation is the error of a parser not finding what it expected
+type expectation struct {
+	expected []string
+	found    string
+}
+
+func (err *expectation) Error() string {
+	return fmt.Sprintf("expected %s, found %s", strings.Join(err.expected, " or "), err.found)
+}
+
+// expected returns the error of a parser expecting something at the position
+func expected(text string, position int, what ...string) error {
+	return &ScanError{Column: position + 1, Err: &expectation{expected: what, found: quoteRest(text[position:])}}
+}
+
+// Literal parses exactly the string s
+func Literal(s string) Parser[string] {
+	return func(text string, position int) (string, int, error) {
+		if !strings.HasPrefix(text[position:], s) {
+			return "", position, expected(text, position, strconv.Quote(s))
+		}
+		return s, position + len(s), nil
+	}
+}
+
+// Int parses a signed integer
+func Int() Parser[int] {
+	return func(text string, position int) (int, int, error) {
+		length := scanInteger(text[position:])
+		if length == 0 {
+			return 0, position, expected(text, position, "an integer")
+		}
+		value, err := strconv.Atoi(text[position : position+length])
+		if err != nil {
+			return 0, position, &ScanError{Column: position + 1, Err: fmt.Errorf("invalid integer %q", text[position:position+length])}
+		}
+		return value, position + length, nil
+	}
+}
+
+// Identifier parses a letter or an underscore, followed by letters, digits or underscores
+func Identifier() Parser[string] {
+	isLetter := func(c byte) bool { return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') }
+	return func(text string, position int) (string, int, error) {
+		end := position
+		for end < len(text) && (isLetter(text[end]) || (end > position && isDigit(text[end]))) {
+			end++
+		}
+		if end == position {
+			return "", position, expected(text, position, "an identifier")
+		}
+		return text[position:end], end, nil
+	}
+}
+
+// OneOf parses a single byte among the characters
+func OneOf(characters string) Parser[byte] {
+	return func(text string, position int) (byte, int, error) {
+		if position >= len(text) || strings.IndexByte(characters, text[position]) < 0 {
+			return 0, position, expected(text, position, fmt.Sprintf("one of %q", characters))
+		}
+		return text[position], position + 1, nil
+	}
+}
+
+// Map converts the value parsed by the parser with the callback function
+func Map[T, U any](parser Parser[T], callback func(T) U) Parser[U] {
+	return func(text string, position int) (mapped U, next int, err error) {
+		value, next, err := parser(text, position)
+		if err != nil {
+			return mapped, position, err
+		}
+		return callback(value), next, nil
+	}
+}
+
+// Seq2 parses a then b, and combines their values with the callback function
+func Seq2[A, B, T any](a Parser[A], b Parser[B], callback func(A, B) T) Parser[T] {
+	return func(text string, position int) (value T, next int, err error) {
+		va, next, err := a(text, position)
+		if err != nil {
+			return value, position, err
+		}
+		vb, next, err := b(text, next)
+		if err != nil {
+			return value, position, err
+		}
+		return callback(va, vb), next, nil
+	}
+}
+
+// Seq3 parses a, b then c, and combines their values with the callback function
+func Seq3[A, B, C, T any](a Parser[A], b Parser[B], c Parser[C], callback func(A, B, C) T) Parser[T] {
+	type pair struct {
+		a A
+		b B
+	}
+	ab := Seq2(a, b, func(va A, vb B) pair { return pair{va, vb} })
+	return Seq2(ab, c, func(ab pair, vc C) T { return callback(ab.a, ab.b, vc) })
+}
+
+// Seq4 parses a, b, c then d, and combines their values with the callback function
+func Seq4[A, B, C, D, T any](a Parser[A], b Parser[B], c Parser[C], d Parser[D], callback func(A, B, C, D) T) Parser[T] {
+	type triple struct {
+		a A
+		b B
+		c C
+	}
+	abc := Seq3(a, b, c, func(va A, vb B, vc C) triple { return triple{va, vb, vc} })
+	return Seq2(abc, d, func(abc triple, vd D) T { return callback(abc.a, abc.b, abc.c, vd) })
+}
+
+// Prefixed parses the prefix then the parser, and returns the value of the parser
+func Prefixed[T any](prefix string, parser Parser[T]) Parser[T] {
+	return Seq2(Literal(prefix), parser, func(_ string, value T) T { return value })
+}
+
+// Between parses the opening string, the parser, then the closing string, and returns the value of the parser
+func Between[T any](opening string, parser Parser[T], closing string) Parser[T] {
+	return Seq2(Prefixed(opening, parser), Literal(closing), func(value T, _ string) T { return value })
+}
+
+// Or returns the value of the first parser succeeding
+// when all fail, the error is the one of the parser that went the furthest, merging what was expected at this position
+func Or[T any](parsers ...Parser[T]) Parser[T] {
+	return func(text string, position int) (value T, next int, err error) {
+		var furthest *ScanError
+		for _, parser := range parsers {
+			value, next, err := parser(text, position)
+			if err == nil {
+				return value, next, nil
+			}
+
+			located := (*ScanError)(nil)
+			if !errors.As(err, &located) {
+				return value, position, err
+			}
+			switch {
+			case furthest == nil || located.Column > furthest.Column:
+				furthest = &ScanError{Column: located.Column, Err: located.Err}
+			case located.Column == furthest.Column:
+				expectedA, okA := furthest.Err.(*expectation)
+				expectedB, okB := located.Err.(*expectation)
+				if okA && okB {
+					merged := slices.Clone(expectedA.expected)
+					for _, what := range expectedB.expected {
+						if !slices.Contains(merged, what) {
+							merged = append(merged, what)
+						}
+					}
+					furthest.Err = &expectation{expected: merged, found: expectedA.found}
+				}
+			}
+		}
+		if furthest == nil {
+			return value, position, expected(text, position, "something")
+		}
+		return value, position, furthest
+	}
+}
+
+// Many parses the parser as many times as possible, possibly none
+// it stops at the first failure that did not consume anything, a failure after having consumed text is an error
+func Many[T any](parser Parser[T]) Parser[[]T] {
+	return func(text string, position int) ([]T, int, error) {
+		values := []T{}
+		for position < len(text) {
+			value, next, err := parser(text, position)
+			if err != nil {
+				if located := (*ScanError)(nil); errors.As(err, &located) && located.Column == position+1 {
+					break
+				}
+				return values, position, err
+			}
+			if next == position {
+				break
+			}
+			values, position = append(values, value), next
+		}
+		return values, position, nil
+	}
+}
+
+// SeparatedBy parses a possibly empty list of values, separated by the separator string
+func SeparatedBy[T any](parser Parser[T], separator string) Parser[[]T] {
+	return func(text string, position int) ([]T, int, error) {
+		values := []T{}
+		value, next, err := parser(text, position)
+		if err != nil {
+			// an empty list, unless the parser failed after having consumed text
+			if located := (*ScanError)(nil); errors.As(err, &located) && located.Column == position+1 {
+				return values, position, nil
+			}
+			return values, position, err
+		}
+
+		values = append(values, value)
+		for strings.HasPrefix(text[next:], separator) {
+			value, next, err = parser(text, next+len(separator))
+			if err != nil {
+				return values, position, err
+			}
+			values = append(values, value)
+		}
+		return values, next, nil
+	}
+}
+
+// Recursive returns a parser referencing itself, such as nested lists
+// the definition callback function receives the parser being defined, to use it in its own definition
+func Recursive[T any](definition func(Parser[T]) Parser[T]) Parser[T] {
+	var parser Parser[T]
+	parser = definition(func(text string, position int) (T, int, error) {
+		return parser(text, position)
+	})
+	return parser
+}
@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParser(t *testing.T) {
+	list := Recursive(func(list Parser[any]) Parser[any] {
+		item := Or(Map(Int(), func(i int) any { return i }), list)
+		return Map(Between("[", SeparatedBy(item, ","), "]"), func(items []any) any { return items })
+	})
+
+	tests := []struct {
+		input string
+		value string
+		err   string
+	}{
+		{"[]", "[]", ""},
+		{"[1,[2,-3],[[]]]", "[1 [2 -3] [[]]]", ""},
+		{"[1,x]", "", `column 4: expected an integer or "[", found "x]"`},
+		{"[1,2", "", `column 5: expected "]", found the end of the line`},
+		{"[1]]", "", `column 4: expected the end of the line, found "]"`},
+	}
+	for _, test := range tests {
+		value, err := list.Parse(test.input)
+		if test.err != "" {
+			if err == nil || err.Error() != test.err {
+				t.Errorf("Parse(%q) error = %v, want %s", test.input, err, test.err)
+			}
+		} else if err != nil || fmt.Sprint(value) != test.value {
+			t.Errorf("Parse(%q) = %v, %v, want %s", test.input, value, err, test.value)
+		}
+	}
+
+	pair := Seq3(Identifier(), OneOf("=:"), Int(), func(name string, _ byte, value int) string { return fmt.Sprint(name, value) })
+	if _, err := ParseLines(pair, []string{"a=1", "b_2:2", "3=3"}); err == nil || err.Error() != `line 3 column 1: expected an identifier, found "3=3"` {
+		t.Errorf("ParseLines() error = %v", err)
+	}
+}
@@ -0,0 +1,152 @@
+package utils
+
+import (
+	"cmp"
+)
+
+// Counter implements a multiset, counting the occurrences of each element
+// being a map, it can be iterated with range, elements whose count drops to zero are removed
+type Counter[T comparable] map[T]int
+
+// Counted is an element along with its number of occurrences
+type Counted[T comparable] struct {
+	Value T
+	Count int
+}
+
+// NewCounter is a quick way to get a Counter of the occurrences of the list of elements
+func NewCounter[T comparable](elems ...T) Counter[T] {
+	counter := make(Counter[T], len(elems))
+	for _, elem := range elems {
+		counter[elem]++
+	}
+	return counter
+}
+
+// Add adds n occurrences of the element
+func (counter Counter[T]) Add(elem T, n int) {
+	if counter[elem] += n; counter[elem] <= 0 {
+		delete(counter, elem)
+	}
+}
+
+// Remove removes n occurrences of the element, the count never going below zero
+func (counter Counter[T]) Remove(elem T, n int) {
+	counter.Add(elem, -n)
+}
+
+// Count returns the number of occurrences of the element
+func (counter Counter[T]) Count(elem T) int {
+	return counter[elem]
+}
+
+// Len returns the number of distinct elements
+func (counter Counter[T]) Len() int {
+	return len(counter)
+}
+
+// Total returns the number of occurrences of all the elements
+func (counter Counter[T]) Total() (total int) {
+	for _, count := range counter {
+		total += count
+	}
+	return total
+}
+
+// Merge adds the occurrences of the others
+func (counter Counter[T]) Merge(others ...Counter[T]) {
+	for _, other := range others {
+		for elem, count := range other {
+			counter.Add(elem, count)
+		}
+	}
+}
+
+// Subtract removes the occurrences of the others
+func (counter Counter[T]) Subtract(others ...Counter[T]) {
+	for _, other := range others {
+		for elem, count := range other {
+			counter.Remove(elem, count)
+		}
+	}
+}
+
+// entries returns the elements along with their count
+func (counter Counter[T]) entries() []Counted[T] {
+	entries := make([]Counted[T], 0, len(counter))
+	for elem, count := range counter {
+		entries = append(entries, Counted[T]{Value: elem, Count: count})
+	}
+	return entries
+}
+
+// MostCommon returns the k elements having the most occurrences, from the most to the least common
+// elements having the same count are in no particular order
+func (counter Counter[T]) MostCommon(k int) []Counted[T] {
+	return TopK(counter.entries(), k, func(a, b Counted[T]) int { return cmp.Compare(a.Count, b.Count) })
+}
+
+// LeastCommon returns the k elements having the fewest occurrences, from the least to the most common
+// elements having the same count are in no particular order
+func (counter Counter[T]) LeastCommon(k int) []Counted[T] {
+	return TopK(counter.entries(), k, func(a, b Counted[T]) int { return cmp.Compare(b.Count, a.Count) })
+}
+
+// TopK returns the k greatest elements according to the comparison function, from the greatest to the smallest
+// it keeps the best elements in a bounded heap of size k, which is cheaper than sorting all the elements
+func TopK[S ~[]E, E any](elems S, k int, compare func(a, b E) int) []E {
+	k = min(k, len(elems))
+	if k <= 0 {
+		return []E{}
+	}
+
+	// min-heap of the k greatest elements seen so far, its root being the smallest of them
+	heap := make([]E, 0, k)
+	down := func(i int) {
+		for {
+			smallest := i
+			for _, child := range [2]int{2*i + 1, 2*i + 2} {
+				if child < len(heap) && compare(heap[child], heap[smallest]) < 0 {
+					smallest = child
+				}
+			}
+			if smallest == i {
+				return
+			}
+			heap[i], heap[smallest] = heap[smallest], heap[i]
+			i = smallest
+		}
+	}
+
+	for _, elem := range elems {
+		switch {
+		case len(heap) < k:
+			heap = append(heap, elem)
+			for i := len(heap) - 1; i > 0 && compare(heap[i], heap[(i-1)/2]) < 0; i = (i - 1) / 2 {
+				heap[i], heap[(i-1)/2] = heap[(i-1)/2], heap[i]
+			}
+		case compare(elem, heap[0]) > 0:
+			heap[0] = elem
+			down(0)
+		}
+	}
+
+	// pop the smallest elements to the end, leaving the heap sorted from the greatest to the smallest
+	for n := len(heap) - 1; n > 0; n-- {
+		heap[0], heap[n] = heap[n], heap[0]
+		heap = heap[:n]
+		down(0)
+		heap = heap[:cap(heap)]
+	}
+	return heap
+}
+
+// Largest returns the k largest elements, from the largest to the smallest
+func Largest[S ~[]E, E cmp.Ordered](elems S, k int) []E {
+	return TopK(elems, k, cmp.Compare[E])
+}
+
+// Smallest returns the k smallest elements, from the smallest to the largest
+func Smallest[S ~[]E, E cmp.Ordered](elems S, k int) []E {
+	return TopK(elems, k, func(a, b E) int { return cmp.Compare(b, a) })
+}
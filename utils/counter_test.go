@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestCounter(t *testing.T) {
+	counter := NewCounter([]rune("abracadabra")...)
+	if counter.Count('a') != 5 || counter.Count('z') != 0 || counter.Len() != 5 || counter.Total() != 11 {
+		t.Errorf("NewCounter() = %v", counter)
+	}
+
+	if most := counter.MostCommon(2); !slices.Equal(most, []Counted[rune]{{'a', 5}, {'b', 2}}) && !slices.Equal(most, []Counted[rune]{{'a', 5}, {'r', 2}}) {
+		t.Errorf("MostCommon(2) = %v", most)
+	}
+	if least := counter.LeastCommon(10); len(least) != 5 || least[0].Count != 1 || least[4] != (Counted[rune]{'a', 5}) {
+		t.Errorf("LeastCommon(10) = %v", least)
+	}
+
+	counter.Remove('c', 3)
+	counter.Subtract(NewCounter('a', 'a'))
+	counter.Merge(NewCounter('z'))
+	if _, found := counter['c']; found || counter.Count('a') != 3 || counter.Count('z') != 1 {
+		t.Errorf("counter after Remove, Subtract and Merge = %v", counter)
+	}
+}
+
+func TestTopK(t *testing.T) {
+	elems := rand.Perm(100)
+	if top := Largest(elems, 3); !slices.Equal(top, []int{99, 98, 97}) {
+		t.Errorf("Largest(3) = %v", top)
+	}
+	if bottom := Smallest(elems, 4); !slices.Equal(bottom, []int{0, 1, 2, 3}) {
+		t.Errorf("Smallest(4) = %v", bottom)
+	}
+	if all := Largest([]int{2, 3, 1}, 5); !slices.Equal(all, []int{3, 2, 1}) {
+		t.Errorf("Largest(5) of 3 elements = %v", all)
+	}
+	if none := Largest(elems, 0); len(none) != 0 {
+		t.Errorf("Largest(0) = %v", none)
+	}
+}
@@ -0,0 +1,215 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Directions4 are the offsets to the 4 orthogonal neighbours: up, right, down, left
+var Directions4 = [4]Location2D[int]{{X: 0, Y: -1}, {X: 1, Y: 0}, {X: 0, Y: 1}, {X: -1, Y: 0}}
+
+// Directions8 are the offsets to the 8 neighbours, clockwise starting from up
+var Directions8 = [8]Location2D[int]{
+	{X: 0, Y: -1}, {X: 1, Y: -1}, {X: 1, Y: 0}, {X: 1, Y: 1},
+	{X: 0, Y: 1}, {X: -1, Y: 1}, {X: -1, Y: 0}, {X: -1, Y: -1},
+}
+
+// Grid implements a rectangular 2D grid, with cells stored row by row in a flat slice
+// X goes from left to right, and Y from top to bottom
+type Grid[T any] struct {
+	Width, Height int
+	cells         []T
+}
+
+// NewGrid is a quick way to get a Grid of the given dimensions, filled with zero values
+func NewGrid[T any](width, height int) Grid[T] {
+	return Grid[T]{Width: width, Height: height, cells: make([]T, width*height)}
+}
+
+// ParseGrid returns the grid of the lines, each byte being converted to a cell by the callback function
+// ragged lines are padded: the grid is as wide as the longest line, and the missing cells of shorter lines are zero values,
+// not converted by the callback function; use Validate with Rectangular to reject ragged lines instead
+func ParseGrid[T any](lines []string, callback func(byte) T) Grid[T] {
+	width := 0
+	for _, line := range lines {
+		width = max(width, len(line))
+	}
+
+	grid := NewGrid[T](width, len(lines))
+	for y, line := range lines {
+		row := grid.Row(y)
+		for x := 0; x < len(line); x++ {
+			row[x] = callback(line[x])
+		}
+	}
+	return grid
+}
+
+// ParseByteGrid returns the grid of the lines, cells being the bytes of the lines
+func ParseByteGrid(lines []string) Grid[byte] {
+	return ParseGrid(lines, func(b byte) byte { return b })
+}
+
+// index returns the index of the location in the cells
+func (grid Grid[T]) index(location Location2D[int]) int {
+	return location.Y*grid.Width + location.X
+}
+
+// InBounds tells whether the location is inside the grid
+func (grid Grid[T]) InBounds(location Location2D[int]) bool {
+	return location.X >= 0 && location.X < grid.Width && location.Y >= 0 && location.Y < grid.Height
+}
+
+// At returns the cell at the location, that must be inside the grid
+func (grid Grid[T]) At(location Location2D[int]) T {
+	return grid.cells[grid.index(location)]
+}
+
+// Get returns the cell at the location, and false if the location is outside the grid
+func (grid Grid[T]) Get(location Location2D[int]) (cell T, found bool) {
+	if !grid.InBounds(location) {
+		return cell, false
+	}
+	return grid.At(location), true
+}
+
+// Set sets the cell at the location, that must be inside the grid
+func (grid Grid[T]) Set(location Location2D[int], cell T) {
+	grid.cells[grid.index(location)] = cell
+}
+
+// Wrap returns the location inside the grid matching the location, the grid being infinitely repeated in all directions
+func (grid Grid[T]) Wrap(location Location2D[int]) Location2D[int] {
+	return NewLocation2D(Mod(location.X, grid.Width), Mod(location.Y, grid.Height))
+}
+
+// AtWrapped returns the cell at any location, the grid being infinitely repeated in all directions (toroidal access)
+func (grid Grid[T]) AtWrapped(location Location2D[int]) T {
+	return grid.At(grid.Wrap(location))
+}
+
+// Neighbours4 returns the orthogonal neighbours of the location that are inside the grid
+func (grid Grid[T]) Neighbours4(location Location2D[int]) []Location2D[int] {
+	return grid.neighbours(location, Directions4[:])
+}
+
+// Neighbours8 returns the orthogonal and diagonal neighbours of the location that are inside the grid
+func (grid Grid[T]) Neighbours8(location Location2D[int]) []Location2D[int] {
+	return grid.neighbours(location, Directions8[:])
+}
+
+func (grid Grid[T]) neighbours(location Location2D[int], directions []Location2D[int]) []Location2D[int] {
+	neighbours := make([]Location2D[int], 0, len(directions))
+	for _, direction := range directions {
+		if neighbour := location.MovedBy(direction.X, direction.Y); grid.InBounds(neighbour) {
+			neighbours = append(neighbours, neighbour)
+		}
+	}
+	return neighbours
+}
+
+// Row returns the cells of the row y, the slice shares the grid storage so updating it updates the grid
+func (grid Grid[T]) Row(y int) []T {
+	return grid.cells[y*grid.Width : (y+1)*grid.Width : (y+1)*grid.Width]
+}
+
+// ColumnCopy returns a copy of the cells of the column x: unlike a row, a column is not contiguous in the grid storage,
+// so it cannot share it, and updating the copy leaves the grid unchanged
+func (grid Grid[T]) ColumnCopy(x int) []T {
+	column := make([]T, grid.Height)
+	for y := range column {
+		column[y] = grid.cells[y*grid.Width+x]
+	}
+	return column
+}
+
+// Each calls the callback function for every cell, row by row
+func (grid Grid[T]) Each(callback func(Location2D[int], T)) {
+	for i, cell := range grid.cells {
+		callback(NewLocation2D(i%grid.Width, i/grid.Width), cell)
+	}
+}
+
+// Find returns the location of the first cell, row by row, for which the condition returned true
+func (grid Grid[T]) Find(cond func(T) bool) (Location2D[int], bool) {
+	for i, cell := range grid.cells {
+		if cond(cell) {
+			return NewLocation2D(i%grid.Width, i/grid.Width), true
+		}
+	}
+	return Location2D[int]{}, false
+}
+
+// Count returns the number of cells for which the condition returned true
+func (grid Grid[T]) Count(cond func(T) bool) (count int) {
+	for _, cell := range grid.cells {
+		if cond(cell) {
+			count++
+		}
+	}
+	return count
+}
+
+// Clone returns a copy of the grid, not sharing its storage
+func (grid Grid[T]) Clone() Grid[T] {
+	return Grid[T]{Width: grid.Width, Height: grid.Height, cells: append([]T(nil), grid.cells...)}
+}
+
+// remap returns a new grid of the given dimensions, whose cell at each location is the source cell at the mapped location
+func (grid Grid[T]) remap(width, height int, source func(x, y int) Location2D[int]) Grid[T] {
+	result := NewGrid[T](width, height)
+	for y := 0; y < height; y++ {
+		row := result.Row(y)
+		for x := range row {
+			row[x] = grid.At(source(x, y))
+		}
+	}
+	return result
+}
+
+// Transpose returns a new grid with rows and columns swapped
+func (grid Grid[T]) Transpose() Grid[T] {
+	return grid.remap(grid.Height, grid.Width, func(x, y int) Location2D[int] { return NewLocation2D(y, x) })
+}
+
+// RotateClockwise returns a new grid rotated by a quarter turn clockwise
+func (grid Grid[T]) RotateClockwise() Grid[T] {
+	return grid.remap(grid.Height, grid.Width, func(x, y int) Location2D[int] { return NewLocation2D(y, grid.Height-1-x) })
+}
+
+// RotateCounterClockwise returns a new grid rotated by a quarter turn counter clockwise
+func (grid Grid[T]) RotateCounterClockwise() Grid[T] {
+	return grid.remap(grid.Height, grid.Width, func(x, y int) Location2D[int] { return NewLocation2D(grid.Width-1-y, x) })
+}
+
+// FlipHorizontal returns a new grid mirrored left to right
+func (grid Grid[T]) FlipHorizontal() Grid[T] {
+	return grid.remap(grid.Width, grid.Height, func(x, y int) Location2D[int] { return NewLocation2D(grid.Width-1-x, y) })
+}
+
+// FlipVertical returns a new grid mirrored top to bottom
+func (grid Grid[T]) FlipVertical() Grid[T] {
+	return grid.remap(grid.Width, grid.Height, func(x, y int) Location2D[int] { return NewLocation2D(x, grid.Height-1-y) })
+}
+
+// String returns a representation of the grid, one line per row
+// bytes and runes are written as characters, other cells as formatted by fmt
+func (grid Grid[T]) String() string {
+	builder := strings.Builder{}
+	for y := 0; y < grid.Height; y++ {
+		if y > 0 {
+			builder.WriteByte('\n')
+		}
+		for _, cell := range grid.Row(y) {
+			switch cell := any(cell).(type) {
+			case byte:
+				builder.WriteByte(cell)
+			case rune:
+				builder.WriteRune(cell)
+			default:
+				fmt.Fprint(&builder, cell)
+			}
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,117 @@
+package utils
+
+import (
+	"slices"
+	"testing"
+)
+
+// abc
+// def
+var gridLines = []string{"abc", "def"}
+
+func TestGridTransforms(t *testing.T) {
+	grid := ParseByteGrid(gridLines)
+	tests := []struct {
+		name string
+		grid Grid[byte]
+		want string
+	}{
+		{"Transpose", grid.Transpose(), "ad\nbe\ncf"},
+		{"RotateClockwise", grid.RotateClockwise(), "da\neb\nfc"},
+		{"RotateCounterClockwise", grid.RotateCounterClockwise(), "cf\nbe\nad"},
+		{"FlipHorizontal", grid.FlipHorizontal(), "cba\nfed"},
+		{"FlipVertical", grid.FlipVertical(), "def\nabc"},
+		{"RotateClockwise x4", grid.RotateClockwise().RotateClockwise().RotateClockwise().RotateClockwise(), "abc\ndef"},
+		{"RotateClockwise then counter", grid.RotateClockwise().RotateCounterClockwise(), "abc\ndef"},
+	}
+	for _, test := range tests {
+		if got := test.grid.String(); got != test.want {
+			t.Errorf("%s() = %q, want %q", test.name, got, test.want)
+		}
+	}
+
+	// transforms return new grids, leaving the source unchanged
+	if grid.String() != "abc\ndef" || grid.Width != 3 || grid.Height != 2 {
+		t.Errorf("source grid changed to %q", grid.String())
+	}
+}
+
+func TestGridAccess(t *testing.T) {
+	grid := ParseByteGrid(gridLines)
+	if cell := grid.At(NewLocation2D(2, 1)); cell != 'f' {
+		t.Errorf("At(2, 1) = %c", cell)
+	}
+	if _, found := grid.Get(NewLocation2D(3, 0)); found {
+		t.Errorf("Get(3, 0) found a cell outside the grid")
+	}
+	if _, found := grid.Get(NewLocation2D(0, -1)); found {
+		t.Errorf("Get(0, -1) found a cell outside the grid")
+	}
+	if column := grid.ColumnCopy(1); string(column) != "be" {
+		t.Errorf("ColumnCopy(1) = %q", column)
+	}
+
+	// rows share the grid storage, columns do not
+	grid.Row(0)[0] = 'A'
+	grid.ColumnCopy(1)[0] = 'B'
+	if grid.String() != "Abc\ndef" {
+		t.Errorf("grid after updating a row and a column = %q", grid.String())
+	}
+
+	wrapped := []struct{ x, y, wantX, wantY int }{{3, 0, 0, 0}, {-1, 0, 2, 0}, {-4, -3, 2, 1}, {7, 5, 1, 1}}
+	for _, test := range wrapped {
+		if location := grid.Wrap(NewLocation2D(test.x, test.y)); location != NewLocation2D(test.wantX, test.wantY) {
+			t.Errorf("Wrap(%d, %d) = %v, want (%d, %d)", test.x, test.y, location, test.wantX, test.wantY)
+		}
+	}
+	if cell := grid.AtWrapped(NewLocation2D(-1, -1)); cell != 'f' {
+		t.Errorf("AtWrapped(-1, -1) = %c", cell)
+	}
+}
+
+func TestGridNeighbours(t *testing.T) {
+	grid := ParseByteGrid(gridLines)
+	tests := []struct {
+		name       string
+		neighbours []Location2D[int]
+		want       []Location2D[int]
+	}{
+		{"Neighbours4(0, 0)", grid.Neighbours4(NewLocation2D(0, 0)), []Location2D[int]{{X: 1, Y: 0}, {X: 0, Y: 1}}},
+		{"Neighbours4(1, 1)", grid.Neighbours4(NewLocation2D(1, 1)), []Location2D[int]{{X: 1, Y: 0}, {X: 2, Y: 1}, {X: 0, Y: 1}}},
+		{"Neighbours8(2, 1)", grid.Neighbours8(NewLocation2D(2, 1)), []Location2D[int]{{X: 2, Y: 0}, {X: 1, Y: 1}, {X: 1, Y: 0}}},
+		{"Neighbours8(1, 0)", grid.Neighbours8(NewLocation2D(1, 0)), []Location2D[int]{{X: 2, Y: 0}, {X: 2, Y: 1}, {X: 1, Y: 1}, {X: 0, Y: 1}, {X: 0, Y: 0}}},
+		{"Neighbours4(5, 5)", grid.Neighbours4(NewLocation2D(5, 5)), []Location2D[int]{}},
+		{"Neighbours8(-1, -1)", grid.Neighbours8(NewLocation2D(-1, -1)), []Location2D[int]{{X: 0, Y: 0}}},
+	}
+	for _, test := range tests {
+		if !slices.Equal(test.neighbours, test.want) {
+			t.Errorf("%s = %v, want %v", test.name, test.neighbours, test.want)
+		}
+	}
+}
+
+func TestParseGrid(t *testing.T) {
+	// ragged lines are padded with zero values, not converted by the callback
+	grid := ParseGrid([]string{"#.#", "#", ""}, func(b byte) int {
+		if b == '#' {
+			return 1
+		}
+		return 2
+	})
+	if grid.Width != 3 || grid.Height != 3 {
+		t.Fatalf("ParseGrid() dimensions = %dx%d, want 3x3", grid.Width, grid.Height)
+	}
+	if got := grid.String(); got != "121\n100\n000" {
+		t.Errorf("ParseGrid() = %q", got)
+	}
+	if count := grid.Count(func(cell int) bool { return cell == 0 }); count != 5 {
+		t.Errorf("Count() of padded cells = %d, want 5", count)
+	}
+	if location, found := grid.Find(func(cell int) bool { return cell == 2 }); !found || location != NewLocation2D(1, 0) {
+		t.Errorf("Find() = %v, %v", location, found)
+	}
+
+	if empty := ParseByteGrid(nil); empty.Width != 0 || empty.Height != 0 || empty.String() != "" {
+		t.Errorf("ParseByteGrid(nil) = %dx%d", empty.Width, empty.Height)
+	}
+}
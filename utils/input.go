@@ -2,54 +2,190 @@ package utils
 
 import (
 	"bufio"
+	"bytes"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
-	"runtime"
+	"strings"
+	"sync"
 )
 
-func MustReadInput(name string) []string {
-	input, err := ReadInput(name)
+// Environment variables used as defaults for the inputs configuration
+const (
+	EnvInput = "AOC_INPUT" // input source: example, input, - (stdin) or a file path
+	EnvRoot  = "AOC_ROOT"  // directory containing the year/day directories
+)
+
+// Input sources
+const (
+	SourceExample = "example"
+	SourceInput   = "input"
+	SourceStdin   = "-"
+)
+
+// Inputs locates and reads the puzzle inputs
+// puzzles live in Root/year/day, and are read from the file matching the Source:
+//   - "example": exampleN.txt for part N if it exists, else example.txt
+//   - "input": inputN.txt for part N if it exists, else input.txt
+//   - "-": the standard input, shared by both parts
+//   - anything else: the explicit path of the file to read, shared by both parts
+type Inputs struct {
+	Root          string
+	Source        string
+	Stdin         io.Reader
+	MaxLineLength int // maximum length of the lines returned by Read, unlimited when 0
+
+	stdinOnce sync.Once
+	stdinData []byte
+	stdinErr  error
+}
+
+// NewInputs returns the inputs configuration read from the environment
+// when not set, the source is the example, and the root is the closest parent directory holding a go.mod
+func NewInputs() *Inputs {
+	inputs := &Inputs{Root: os.Getenv(EnvRoot), Source: os.Getenv(EnvInput), Stdin: os.Stdin}
+	if inputs.Source == "" {
+		inputs.Source = SourceExample
+	}
+	if inputs.Root == "" {
+		inputs.Root = FindRoot()
+	}
+	return inputs
+}
+
+// RegisterFlags binds the inputs configuration to command line flags, using the current values as defaults
+func (inputs *Inputs) RegisterFlags(flags *flag.FlagSet) {
+	flags.StringVar(&inputs.Source, "input", inputs.Source, "input source: example, input, - for stdin, or a file path (env "+EnvInput+")")
+	flags.StringVar(&inputs.Root, "root", inputs.Root, "directory containing the year/day directories (env "+EnvRoot+")")
+}
+
+// Dir returns the directory of the puzzle
+func (inputs *Inputs) Dir(year, day int) string {
+	return filepath.Join(inputs.Root, fmt.Sprint(year), fmt.Sprintf("%02d", day))
+}
+
+// Candidates returns the files tried, by order of preference, to read the input of the puzzle part
+// it is empty when reading from the standard input
+func (inputs *Inputs) Candidates(year, day, part int) []string {
+	switch inputs.Source {
+	case SourceStdin:
+		return nil
+	case SourceExample, SourceInput:
+		dir := inputs.Dir(year, day)
+		return []string{
+			filepath.Join(dir, fmt.Sprintf("%s%d.txt", inputs.Source, part)),
+			filepath.Join(dir, inputs.Source+".txt"),
+		}
+	default:
+		return []string{inputs.Source}
+	}
+}
+
+// Read returns the lines of the input of the puzzle part, along with the input name (file name without extension)
+func (inputs *Inputs) Read(year, day, part int) (name string, lines []string, err error) {
+	input, err := inputs.Open(year, day, part)
 	if err != nil {
-		panic(err)
+		return "", nil, err
 	}
-	return input
+	defer input.Close()
+
+	reader := input.Lines()
+	reader.MaxLength = inputs.MaxLineLength
+	lines, err = readLines(reader)
+	return input.Name, lines, err
 }
 
-func ReadInput(name string) ([]string, error) {
-	// retrieve caller file
-	_, current, _, _ := runtime.Caller(0)
-	file := ""
-	for i, ok := 1, true; ok; i++ {
-		_, file, _, ok = runtime.Caller(i)
-		if !ok {
-			return nil, fmt.Errorf("fail to get caller to read input")
+// ReadRaw returns the whole input of the puzzle part as a single string, without its final line break
+func (inputs *Inputs) ReadRaw(year, day, part int) (name string, content string, err error) {
+	input, err := inputs.Open(year, day, part)
+	if err != nil {
+		return "", "", err
+	}
+	defer input.Close()
+
+	data, err := io.ReadAll(input)
+	if err != nil {
+		return input.Name, "", fmt.Errorf("error reading input: %w", err)
+	}
+	return input.Name, string(dropLineBreak(data)), nil
+}
+
+// Open opens the input of the puzzle part, to stream it rather than holding all its lines in memory
+// the standard input is read once and kept in memory, as it is shared by both parts
+func (inputs *Inputs) Open(year, day, part int) (*InputReader, error) {
+	if inputs.Source == SourceStdin {
+		inputs.stdinOnce.Do(func() {
+			inputs.stdinData, inputs.stdinErr = io.ReadAll(inputs.Stdin)
+		})
+		if inputs.stdinErr != nil {
+			return nil, fmt.Errorf("error reading input: %w", inputs.stdinErr)
 		}
-		if file != current {
-			break
+		return &InputReader{Name: "stdin", Reader: bufio.NewReader(bytes.NewReader(inputs.stdinData))}, nil
+	}
+
+	candidates := inputs.Candidates(year, day, part)
+	for _, candidate := range candidates {
+		file, err := os.Open(candidate)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
 		}
+		if err != nil {
+			return nil, fmt.Errorf("error opening file: %w", err)
+		}
+		name := strings.TrimSuffix(filepath.Base(candidate), filepath.Ext(candidate))
+		return &InputReader{Name: name, Reader: bufio.NewReader(file), closer: file}, nil
 	}
+	return nil, fmt.Errorf("no input found for %d/%02d part %d (source %q), tried: %s", year, day, part, inputs.Source, strings.Join(candidates, ", "))
+}
 
-	// switch to input path
-	file = filepath.Join(filepath.Dir(file), name)
+// FindRoot returns the closest directory holding a go.mod, starting from the working directory
+// it falls back to the working directory itself if there is none
+func FindRoot() string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return "."
+	}
+	for dir := wd; ; dir = filepath.Dir(dir) {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir
+		}
+		if filepath.Dir(dir) == dir {
+			return wd
+		}
+	}
+}
 
+// ReadLines reads the file at path and returns its content line by line
+func ReadLines(path string) ([]string, error) {
 	// open input
-	input, err := os.Open(file)
+	input, err := os.Open(path)
 	if err != nil {
-		return nil, fmt.Errorf("error opening file: %v", err)
+		return nil, fmt.Errorf("error opening file: %w", err)
 	}
 	defer input.Close()
 
-	//scan the input's contents line by line
+	return ReadLinesFrom(input)
+}
+
+// ReadLinesFrom reads the reader content line by line, whatever the length of the lines
+func ReadLinesFrom(input io.Reader) ([]string, error) {
+	return readLines(NewLineReader(input))
+}
+
+// readLines returns all the lines of the line reader
+func readLines(reader *LineReader) ([]string, error) {
 	lines := make([]string, 0, 1000)
-	scanner := bufio.NewScanner(input)
-	for scanner.Scan() {
-		lines = append(lines, scanner.Text())
+	for reader.Next() {
+		lines = append(lines, reader.Text())
 	}
 
-	// return error if scanning is not done properly
-	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("error reading from file: %v", err)
+	// return error if reading is not done properly
+	if err := reader.Err(); err != nil {
+		return nil, fmt.Errorf("error reading input: %w", err)
 	}
 
 	return lines, nil
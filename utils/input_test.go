@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// writeFiles creates the files of the directory, with their content
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestInputsRead(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"2023/05/example.txt":  "shared\n",
+		"2023/05/example2.txt": "second\n",
+		"2023/05/input.txt":    "personal\n",
+		"custom.txt":           "custom\n",
+	})
+	custom := filepath.Join(root, "custom.txt")
+
+	tests := []struct {
+		source string
+		part   int
+		name   string
+		lines  []string
+	}{
+		{SourceExample, 1, "example", []string{"shared"}},
+		{SourceExample, 2, "example2", []string{"second"}},
+		{SourceInput, 1, "input", []string{"personal"}},
+		{SourceInput, 2, "input", []string{"personal"}},
+		{custom, 1, "custom", []string{"custom"}},
+		{custom, 2, "custom", []string{"custom"}},
+	}
+	for _, test := range tests {
+		inputs := &Inputs{Root: root, Source: test.source}
+		name, lines, err := inputs.Read(2023, 5, test.part)
+		if err != nil || name != test.name || !slices.Equal(lines, test.lines) {
+			t.Errorf("Read() from %s part %d = %s, %q, %v, want %s, %q", test.source, test.part, name, lines, err, test.name, test.lines)
+		}
+	}
+}
+
+func TestInputsCandidates(t *testing.T) {
+	inputs := &Inputs{Root: "root", Source: SourceExample}
+	want := []string{filepath.Join("root", "2023", "05", "example2.txt"), filepath.Join("root", "2023", "05", "example.txt")}
+	if candidates := inputs.Candidates(2023, 5, 2); !slices.Equal(candidates, want) {
+		t.Errorf("Candidates() = %v, want %v", candidates, want)
+	}
+
+	// a missing input reports the files tried
+	inputs.Root = t.TempDir()
+	_, _, err := inputs.Read(2023, 5, 2)
+	if err == nil || !strings.HasPrefix(err.Error(), `no input found for 2023/05 part 2 (source "example"), tried: `) ||
+		!strings.HasSuffix(err.Error(), strings.Join(inputs.Candidates(2023, 5, 2), ", ")) {
+		t.Errorf("Read() of a missing input error = %v", err)
+	}
+	inputs.Source = filepath.Join(inputs.Root, "missing.txt")
+	if _, _, err := inputs.Read(2023, 5, 1); err == nil || !strings.HasSuffix(err.Error(), "tried: "+inputs.Source) {
+		t.Errorf("Read() of a missing file error = %v", err)
+	}
+}
+
+func TestInputsStdin(t *testing.T) {
+	inputs := &Inputs{Root: t.TempDir(), Source: SourceStdin, Stdin: strings.NewReader("a\nb\n")}
+	if candidates := inputs.Candidates(2023, 5, 1); len(candidates) != 0 {
+		t.Errorf("Candidates() of stdin = %v", candidates)
+	}
+
+	// the standard input is read once, and shared by both parts
+	for part := 1; part <= 2; part++ {
+		name, lines, err := inputs.Read(2023, 5, part)
+		if err != nil || name != "stdin" || !slices.Equal(lines, []string{"a", "b"}) {
+			t.Errorf("Read() of stdin part %d = %s, %q, %v", part, name, lines, err)
+		}
+	}
+}
+
+func TestNewInputs(t *testing.T) {
+	t.Setenv(EnvInput, SourceInput)
+	t.Setenv(EnvRoot, "somewhere")
+	if inputs := NewInputs(); inputs.Source != SourceInput || inputs.Root != "somewhere" {
+		t.Errorf("NewInputs() from the environment = %+v", inputs)
+	}
+
+	// by default, the example is read from the closest directory holding a go.mod
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{"go.mod": "module test\n", "2023/05/.keep": ""})
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(filepath.Join(root, "2023", "05")); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	t.Setenv(EnvInput, "")
+	t.Setenv(EnvRoot, "")
+	inputs := NewInputs()
+	if found, _ := filepath.EvalSymlinks(inputs.Root); inputs.Source != SourceExample || found != mustEvalSymlinks(t, root) {
+		t.Errorf("NewInputs() defaults = %+v, want the root %s", inputs, root)
+	}
+}
+
+// mustEvalSymlinks returns the path with its symbolic links resolved, as temporary directories may be behind one
+func mustEvalSymlinks(t *testing.T, path string) string {
+	t.Helper()
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resolved
+}
@@ -0,0 +1,150 @@
+package utils
+
+import "sync"
+
+// Memo caches the values of a function, typically a recursive one, by key
+// a memo lives as long as the value holding it, so creating it in the scope of a computation frees its cache with it
+// the configuration fields must be set before the first call to Get
+type Memo[K comparable, V any] struct {
+	Limit      int  // maximum number of values kept, the least recently used ones being evicted, unlimited when 0
+	Concurrent bool // guards the cache with a mutex, so that the memo can be shared by several goroutines
+
+	compute func(K) V
+	mutex   sync.Mutex
+	values  map[K]V                // cached values, when unlimited
+	entries map[K]*memoEntry[K, V] // cached values, when limited
+	recent  memoEntry[K, V]        // sentinel of the list of entries, from the most to the least recently used
+	stats   MemoStats
+}
+
+// MemoStats holds the statistics of the use of a memo
+type MemoStats struct {
+	Hits      int // number of calls returning a cached value
+	Misses    int // number of calls computing the value
+	Evictions int // number of values evicted to honour the limit
+}
+
+// memoEntry is a cached value in the list of the recently used ones
+type memoEntry[K comparable, V any] struct {
+	key        K
+	value      V
+	prev, next *memoEntry[K, V]
+}
+
+// NewMemo creates a new memo of the function, which can call Get on the memo to recurse
+func NewMemo[K comparable, V any](compute func(K) V) *Memo[K, V] {
+	return &Memo[K, V]{compute: compute}
+}
+
+// Get returns the value of the key, computing it only if it is not cached
+// in concurrent mode, the lock is not held while computing, so that the function can recurse,
+// and goroutines asking for the same missing key may all compute it
+func (memo *Memo[K, V]) Get(key K) V {
+	if value, found := memo.lookup(key); found {
+		return value
+	}
+	value := memo.compute(key)
+	memo.store(key, value)
+	return value
+}
+
+// lookup returns the cached value of the key, if any, and counts the hit or the miss
+func (memo *Memo[K, V]) lookup(key K) (value V, found bool) {
+	if memo.Concurrent {
+		memo.mutex.Lock()
+		defer memo.mutex.Unlock()
+	}
+
+	if memo.Limit > 0 {
+		var entry *memoEntry[K, V]
+		if entry, found = memo.entries[key]; found {
+			memo.unlink(entry)
+			memo.pushFront(entry)
+			value = entry.value
+		}
+	} else {
+		value, found = memo.values[key]
+	}
+
+	if found {
+		memo.stats.Hits++
+	} else {
+		memo.stats.Misses++
+	}
+	return value, found
+}
+
+// store caches the value of the key, evicting the least recently used value if the memo is full
+func (memo *Memo[K, V]) store(key K, value V) {
+	if memo.Concurrent {
+		memo.mutex.Lock()
+		defer memo.mutex.Unlock()
+	}
+
+	if memo.Limit <= 0 {
+		if memo.values == nil {
+			memo.values = make(map[K]V)
+		}
+		memo.values[key] = value
+		return
+	}
+
+	if memo.entries == nil {
+		memo.entries = make(map[K]*memoEntry[K, V], memo.Limit)
+		memo.recent.prev, memo.recent.next = &memo.recent, &memo.recent
+	}
+	if entry, found := memo.entries[key]; found {
+		// computed meanwhile by a recursive call or another goroutine
+		entry.value = value
+		return
+	}
+	if len(memo.entries) >= memo.Limit {
+		oldest := memo.recent.prev
+		memo.unlink(oldest)
+		delete(memo.entries, oldest.key)
+		memo.stats.Evictions++
+	}
+	entry := &memoEntry[K, V]{key: key, value: value}
+	memo.entries[key] = entry
+	memo.pushFront(entry)
+}
+
+// unlink removes the entry from the list of the recently used ones
+func (memo *Memo[K, V]) unlink(entry *memoEntry[K, V]) {
+	entry.prev.next, entry.next.prev = entry.next, entry.prev
+}
+
+// pushFront inserts the entry as the most recently used one
+func (memo *Memo[K, V]) pushFront(entry *memoEntry[K, V]) {
+	entry.prev, entry.next = &memo.recent, memo.recent.next
+	entry.prev.next, entry.next.prev = entry, entry
+}
+
+// Len returns the number of cached values
+func (memo *Memo[K, V]) Len() int {
+	if memo.Concurrent {
+		memo.mutex.Lock()
+		defer memo.mutex.Unlock()
+	}
+	return len(memo.values) + len(memo.entries)
+}
+
+// Stats returns the statistics of the use of the memo since its creation or its last reset
+func (memo *Memo[K, V]) Stats() MemoStats {
+	if memo.Concurrent {
+		memo.mutex.Lock()
+		defer memo.mutex.Unlock()
+	}
+	return memo.stats
+}
+
+// Reset drops the cached values and the statistics, to reuse the memo in a new scope
+func (memo *Memo[K, V]) Reset() {
+	if memo.Concurrent {
+		memo.mutex.Lock()
+		defer memo.mutex.Unlock()
+	}
+	memo.values, memo.entries = nil, nil
+	memo.recent = memoEntry[K, V]{}
+	memo.stats = MemoStats{}
+}
@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"sync"
+	"testing"
+)
+
+func fibonacciMemo() *Memo[int, int] {
+	var memo *Memo[int, int]
+	memo = NewMemo(func(n int) int {
+		if n < 2 {
+			return n
+		}
+		return memo.Get(n-1) + memo.Get(n-2)
+	})
+	return memo
+}
+
+func TestMemo(t *testing.T) {
+	memo := fibonacciMemo()
+	if got := memo.Get(90); got != 2880067194370816120 {
+		t.Fatalf("Get(90) = %d", got)
+	}
+	if stats := memo.Stats(); stats != (MemoStats{Hits: 88, Misses: 91}) || memo.Len() != 91 {
+		t.Errorf("Stats() = %+v, Len() = %d", stats, memo.Len())
+	}
+
+	memo.Get(90)
+	if stats := memo.Stats(); stats.Hits != 89 || stats.Misses != 91 {
+		t.Errorf("Stats() after a cached call = %+v", stats)
+	}
+
+	memo.Reset()
+	if stats := memo.Stats(); stats != (MemoStats{}) || memo.Len() != 0 {
+		t.Errorf("Stats() after Reset = %+v, Len() = %d", stats, memo.Len())
+	}
+}
+
+func TestMemoLimit(t *testing.T) {
+	calls := map[string]int{}
+	memo := NewMemo(func(key string) int { calls[key]++; return len(key) })
+	memo.Limit = 2
+
+	for _, key := range []string{"a", "bb", "a", "ccc", "a", "bb"} {
+		if got := memo.Get(key); got != len(key) {
+			t.Fatalf("Get(%q) = %d", key, got)
+		}
+	}
+	// "bb" is evicted by "ccc", being the least recently used, but "a" is kept
+	if calls["a"] != 1 || calls["bb"] != 2 || calls["ccc"] != 1 {
+		t.Errorf("calls = %v", calls)
+	}
+	if stats := memo.Stats(); stats != (MemoStats{Hits: 2, Misses: 4, Evictions: 2}) || memo.Len() != 2 {
+		t.Errorf("Stats() = %+v, Len() = %d", stats, memo.Len())
+	}
+
+	memo.Reset()
+	memo.Get("dddd")
+	if memo.Len() != 1 || calls["dddd"] != 1 {
+		t.Errorf("Len() after Reset = %d", memo.Len())
+	}
+}
+
+func TestMemoConcurrent(t *testing.T) {
+	memo := fibonacciMemo()
+	memo.Concurrent = true
+	memo.Limit = 50
+
+	var group sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		group.Add(1)
+		go func(i int) {
+			defer group.Done()
+			for n := 0; n <= 90; n++ {
+				memo.Get((n + 10*i) % 91)
+			}
+		}(i)
+	}
+	group.Wait()
+
+	if got := memo.Get(90); got != 2880067194370816120 {
+		t.Errorf("Get(90) = %d", got)
+	}
+	if memo.Len() > 50 {
+		t.Errorf("Len() = %d, limited to 50", memo.Len())
+	}
+}
@@ -0,0 +1,188 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"unsafe"
+)
+
+// the lenient extractors (Ints, Naturals, Int64s, Uints, BigInts) return the numbers found in a string, ignoring any other
+// character, like Numbers does with a regular expression but without allocating anything else than the returned slice
+// the strict parsers (ParseInt, ParseInts, ParseInt64s, ParseUints, ParseIntLines) only accept numbers separated by blanks or commas,
+// and report the line and column of anything else
+
+// Ints returns the signed integers contained in the string, a '-' directly followed by digits being a minus sign
+// it panics if a number overflows an int
+func Ints(s string) []int {
+	return AppendInts(nil, s)
+}
+
+// AppendInts appends the signed integers contained in the string to the slice, and returns the extended slice
+// reusing the same slice across calls extracts numbers without any allocation
+func AppendInts(values []int, s string) []int {
+	return Must(appendIntegers(values, s, true, false))
+}
+
+// Naturals returns the integers made of the digits contained in the string, a '-' being a separator as any other character
+// it is useful for ranges such as "2-4"
+func Naturals(s string) []int {
+	return Must(appendIntegers[int](nil, s, false, false))
+}
+
+// Int64s returns the signed 64 bits integers contained in the string
+func Int64s(s string) []int64 {
+	return Must(appendIntegers[int64](nil, s, true, false))
+}
+
+// Uints returns the unsigned integers made of the digits contained in the string
+func Uints(s string) []uint {
+	return Must(appendIntegers[uint](nil, s, false, false))
+}
+
+// BigInts returns the signed integers of any size contained in the string
+func BigInts(s string) []*big.Int {
+	values := []*big.Int{}
+	for i := 0; i < len(s); {
+		start, end := nextNumber(s, i, true)
+		if start == end {
+			break
+		}
+		value, _ := new(big.Int).SetString(s[start:end], 10)
+		values = append(values, value)
+		i = end
+	}
+	return values
+}
+
+// ParseInts returns the signed integers of the string, that must only contain integers separated by blanks or commas
+func ParseInts(s string) ([]int, error) {
+	return appendIntegers[int](nil, s, true, true)
+}
+
+// ParseInt returns the signed integer of the string, that must only contain it, possibly surrounded by blanks
+func ParseInt(s string) (int, error) {
+	values, err := ParseInts(s)
+	if err != nil {
+		return 0, err
+	}
+	if len(values) != 1 {
+		return 0, &ScanError{Column: 1, Err: fmt.Errorf("expected an integer, found %d in %q", len(values), s)}
+	}
+	return values[0], nil
+}
+
+// ParseInt64s returns the signed 64 bits integers of the string, that must only contain integers separated by blanks or commas
+func ParseInt64s(s string) ([]int64, error) {
+	return appendIntegers[int64](nil, s, true, true)
+}
+
+// ParseUints returns the unsigned integers of the string, that must only contain digits separated by blanks or commas
+func ParseUints(s string) ([]uint, error) {
+	return appendIntegers[uint](nil, s, false, true)
+}
+
+// ParseIntLines returns the signed integers of each line, as parsed by ParseInts
+// errors are located at the line and column of the offending character
+func ParseIntLines(lines []string) ([][]int, error) {
+	values := make([][]int, len(lines))
+	for i, line := range lines {
+		var err error
+		if values[i], err = ParseInts(line); err != nil {
+			err.(*ScanError).Line = i + 1
+			return values, err
+		}
+	}
+	return values, nil
+}
+
+// nextNumber returns the bounds of the first number of the string starting at i, empty bounds if there is none
+func nextNumber(s string, i int, signed bool) (start, end int) {
+	for ; i < len(s); i++ {
+		if isDigit(s[i]) {
+			start = i
+			if signed && i > 0 && s[i-1] == '-' {
+				start--
+			}
+			for i < len(s) && isDigit(s[i]) {
+				i++
+			}
+			return start, i
+		}
+	}
+	return len(s), len(s)
+}
+
+// appendIntegers appends the integers of the string to the values
+// in strict mode, only blanks and commas are accepted between numbers, and a sign must be followed by a digit
+func appendIntegers[T integer](values []T, s string, signed, strict bool) ([]T, error) {
+	var zero T
+	bits := unsafe.Sizeof(zero) * 8
+	limit := uint64(math.MaxUint64) >> (64 - bits) // magnitude of the largest positive value
+	if ^zero < 0 {
+		limit >>= 1
+	}
+
+	failed := func(column int, format string, args ...any) ([]T, error) {
+		return values, &ScanError{Column: column + 1, Err: fmt.Errorf(format, args...)}
+	}
+
+	for i := 0; i < len(s); {
+		c := s[i]
+		negative := false
+		switch {
+		case signed && (c == '-' || c == '+') && i+1 < len(s) && isDigit(s[i+1]):
+			negative = c == '-'
+			i++
+		case isDigit(c):
+		case !strict || isNumberSeparator(c):
+			i++
+			continue
+		case signed && (c == '-' || c == '+'):
+			return failed(i, "sign %q not followed by a digit", c)
+		default:
+			return failed(i, "unexpected %q, expected a number", c)
+		}
+
+		maximum := limit
+		if negative {
+			maximum++ // only signed types, so it cannot wrap
+		}
+		start, magnitude := i, uint64(0)
+		for ; i < len(s) && isDigit(s[i]); i++ {
+			digit := uint64(s[i] - '0')
+			if magnitude > (maximum-digit)/10 {
+				return failed(start, "number %s overflows %T", numberAt(s, start), zero)
+			}
+			magnitude = magnitude*10 + digit
+		}
+
+		if strict && i < len(s) && !isNumberSeparator(s[i]) {
+			return failed(i, "unexpected %q after number", s[i])
+		}
+
+		if negative {
+			values = append(values, T(-int64(magnitude)))
+		} else {
+			values = append(values, T(magnitude))
+		}
+	}
+	return values, nil
+}
+
+// numberAt returns the digits of the string starting at i, for error messages
+func numberAt(s string, i int) string {
+	end := i
+	for end < len(s) && isDigit(s[end]) {
+		end++
+	}
+	return s[i:end]
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isNumberSeparator(c byte) bool {
+	return c == ' ' || c == '\t' || c == ','
+}
@@ -0,0 +1,128 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestInts(t *testing.T) {
+	tests := []struct {
+		input    string
+		ints     []int
+		naturals []int
+	}{
+		{"", []int{}, []int{}},
+		{"Time:      7  15   30", []int{7, 15, 30}, []int{7, 15, 30}},
+		{"x=-12, y=+4 2-4", []int{-12, 4, 2, -4}, []int{12, 4, 2, 4}},
+		{"- 3 -x-", []int{3}, []int{3}},
+		{"9223372036854775807 -9223372036854775808", []int{math.MaxInt64, math.MinInt64}, nil},
+	}
+	for _, test := range tests {
+		if ints := Ints(test.input); !slices.Equal(ints, test.ints) {
+			t.Errorf("Ints(%q) = %v, want %v", test.input, ints, test.ints)
+		}
+		if test.naturals == nil {
+			continue
+		}
+		if naturals := Naturals(test.input); !slices.Equal(naturals, test.naturals) {
+			t.Errorf("Naturals(%q) = %v, want %v", test.input, naturals, test.naturals)
+		}
+	}
+
+	if uints := Uints("-1 18446744073709551615"); !slices.Equal(uints, []uint{1, math.MaxUint64}) {
+		t.Errorf("Uints = %v", uints)
+	}
+	if bigs := BigInts("a=-123456789012345678901234567890 b=2"); fmt.Sprint(bigs) != "[-123456789012345678901234567890 2]" {
+		t.Errorf("BigInts = %v", bigs)
+	}
+}
+
+func TestParseInts(t *testing.T) {
+	tests := []struct {
+		input string
+		ints  []int
+		err   string
+	}{
+		{"1,1,3", []int{1, 1, 3}, ""},
+		{" 0 3\t-6 +9 ", []int{0, 3, -6, 9}, ""},
+		{"1 2a", nil, `column 4: unexpected 'a' after number`},
+		{"1 - 2", nil, `column 3: sign '-' not followed by a digit`},
+		{"old * 3", nil, `column 1: unexpected 'o', expected a number`},
+		{"9223372036854775808", nil, `column 1: number 9223372036854775808 overflows int`},
+	}
+	for _, test := range tests {
+		ints, err := ParseInts(test.input)
+		if test.err != "" {
+			if err == nil || err.Error() != test.err {
+				t.Errorf("ParseInts(%q) error = %v, want %s", test.input, err, test.err)
+			}
+		} else if err != nil || !slices.Equal(ints, test.ints) {
+			t.Errorf("ParseInts(%q) = %v, %v, want %v", test.input, ints, err, test.ints)
+		}
+	}
+
+	if _, err := ParseUints("1 -2"); err == nil || err.Error() != `column 3: unexpected '-', expected a number` {
+		t.Errorf("ParseUints error = %v", err)
+	}
+	if value, err := ParseInt(" -42 "); value != -42 || err != nil {
+		t.Errorf("ParseInt = %v, %v", value, err)
+	}
+	if _, err := ParseInt("4 2"); err == nil || err.Error() != `column 1: expected an integer, found 2 in "4 2"` {
+		t.Errorf("ParseInt error = %v", err)
+	}
+	if _, err := ParseIntLines([]string{"1 2", "3 x"}); err == nil || err.Error() != `line 2 column 3: unexpected 'x', expected a number` {
+		t.Errorf("ParseIntLines error = %v", err)
+	}
+}
+
+// benchmarkLine looks like a typical puzzle input line
+var benchmarkLine = "Sensor at x=2389280, y=2368338: closest beacon is at x=2127703, y=-2732666 " + strings.Repeat("12 345 6789 ", 8)
+
+func BenchmarkNumbers(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ArrayMap(Numbers(benchmarkLine), func(s string) int { return Must(strconv.Atoi(s)) })
+	}
+}
+
+func BenchmarkFastNumbers(b *testing.B) {
+	line := strings.Join(Numbers(benchmarkLine), " ")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		FastNumbers(line)
+	}
+}
+
+func BenchmarkInts(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Ints(benchmarkLine)
+	}
+}
+
+func BenchmarkAppendInts(b *testing.B) {
+	values := []int{}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		values = AppendInts(values[:0], benchmarkLine)
+	}
+}
+
+func BenchmarkParseInts(b *testing.B) {
+	line := strings.Join(Numbers(benchmarkLine), " ")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Must(ParseInts(line))
+	}
+}
+
+func BenchmarkBigInts(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		BigInts(benchmarkLine)
+	}
+}
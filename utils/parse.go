@@ -2,8 +2,6 @@ package utils
 
 import (
 	"regexp"
-	"strconv"
-	"strings"
 )
 
 var extractNumbers = regexp.MustCompile(`-?\d+`)
@@ -15,18 +13,12 @@ func Numbers(s string) []string {
 }
 
 // FastNumbers return the list of numbers contained in the input string
-// input string need to contains only digits separated by blanks
+// input string need to contains only numbers separated by blanks or commas, it panics with the column of anything else
 func FastNumbers(s string) []int {
-	return ArrayMap(strings.Fields(s), MustInt)
+	return Must(ParseInts(s))
 }
 
 // Words return the list of words contained in the input string
 func Words(s string) []string {
 	return extractWords.FindAllString(s, -1)
 }
-
-// MustInt interprets a string s as an int. No error check is done
-func MustInt(s string) int {
-	v, _ := strconv.Atoi(s)
-	return v
-}
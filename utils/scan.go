@@ -0,0 +1,434 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Scanner extracts typed values from lines matching a template
+//
+// A template is made of literal text, that must match exactly, and of:
+//   - fields "{name}" or "{name:type}", capturing the text up to the literal text following them (or the end of line)
+//     types are "str" (default for strings), "int" (default for integers), "list" or "list(sep)" splitting the text
+//     into a slice on the separator (", " by default), and "enum(a|b|c)" capturing one of the alternatives;
+//     without a type it is deduced from the value filled, and a field named "_" is matched but not stored
+//   - alternatives "[a|b]", matching one of the literal texts, "[s|]" making a literal optional
+//
+// "{{" and "[[" stand for a literal "{" and "[".
+//
+//	Valve {id} has flow rate={flow:int}; [tunnels lead|tunnel leads] to [valves|valve] {next:list}
+type Scanner struct {
+	template string
+	parts    []scanPart
+	fields   []string // names of the fields filled, in order
+}
+
+// scanPart is either a set of literal alternatives or a field
+type scanPart struct {
+	alternatives []string // literal alternatives, longest first, a single one for plain literal text
+	field        string   // name of the field, empty for a literal
+	kind         string   // type of the field: "", "str", "int", "list" or "enum"
+	separator    string   // separator of a list field
+	column       int      // position of the part in the template, starting at 1
+}
+
+// ScanError reports a line not matching a template, located at the column of the line where the matching failed
+type ScanError struct {
+	Line   int // line number, 0 if unknown
+	Column int // column number of the line, starting at 1
+	Err    error
+}
+
+// Error returns the error message, prefixed by its location
+func (err *ScanError) Error() string {
+	if err.Line > 0 {
+		return fmt.Sprintf("line %d column %d: %v", err.Line, err.Column, err.Err)
+	}
+	return fmt.Sprintf("column %d: %v", err.Column, err.Err)
+}
+
+// Unwrap returns the underlying error
+func (err *ScanError) Unwrap() error {
+	return err.Err
+}
+
+// CompileScanner parses a template, and returns a Scanner able to match lines against it
+func CompileScanner(template string) (*Scanner, error) {
+	scanner := &Scanner{template: template}
+	literal := strings.Builder{}
+	flush := func(column int) {
+		if literal.Len() > 0 {
+			scanner.parts = append(scanner.parts, scanPart{alternatives: []string{literal.String()}, column: column - literal.Len()})
+			literal.Reset()
+		}
+	}
+
+	for i := 0; i < len(template); i++ {
+		switch c := template[i]; {
+		case (c == '{' || c == '[') && strings.HasPrefix(template[i+1:], string(c)):
+			literal.WriteByte(c)
+			i++
+
+		case c == '{' || c == '[':
+			closing := map[byte]string{'{': "}", '[': "]"}[c]
+			end := strings.Index(template[i:], closing)
+			if end < 0 {
+				return nil, fmt.Errorf("template %q column %d: unclosed %q", template, i+1, c)
+			}
+			flush(i + 1)
+			part, err := parseScanPart(c, template[i+1:i+end])
+			if err != nil {
+				return nil, fmt.Errorf("template %q column %d: %w", template, i+1, err)
+			}
+			part.column = i + 1
+			scanner.parts = append(scanner.parts, part)
+			i += end
+
+		default:
+			literal.WriteByte(c)
+		}
+	}
+	flush(len(template) + 1)
+
+	// a field must be delimited by the text following it
+	for i, part := range scanner.parts {
+		if part.field == "" {
+			continue
+		}
+		if part.field != "_" {
+			scanner.fields = append(scanner.fields, part.field)
+		}
+		if i+1 < len(scanner.parts) && part.kind != "enum" && part.kind != "int" {
+			next := scanner.parts[i+1]
+			if next.field != "" || slices.Contains(next.alternatives, "") {
+				return nil, fmt.Errorf("template %q column %d: field {%s} is not followed by a literal text", template, part.column, part.field)
+			}
+		}
+	}
+	return scanner, nil
+}
+
+// parseScanPart parses the content of a field "{...}" or of alternatives "[...]"
+func parseScanPart(opening byte, content string) (scanPart, error) {
+	if opening == '[' {
+		return scanPart{alternatives: sortAlternatives(strings.Split(content, "|"))}, nil
+	}
+
+	name, kind, _ := strings.Cut(content, ":")
+	part := scanPart{field: name}
+	if name == "" {
+		return part, errors.New("field without name")
+	}
+
+	kind, argument, hasArgument := strings.Cut(kind, "(")
+	if hasArgument {
+		if !strings.HasSuffix(argument, ")") {
+			return part, fmt.Errorf("field {%s}: unclosed type argument", name)
+		}
+		argument = strings.TrimSuffix(argument, ")")
+	}
+
+	switch part.kind = kind; kind {
+	case "", "str", "int":
+		if hasArgument {
+			return part, fmt.Errorf("field {%s}: type %s takes no argument", name, kind)
+		}
+	case "list":
+		part.separator = ", "
+		if hasArgument {
+			part.separator = argument
+		}
+		if part.separator == "" {
+			return part, fmt.Errorf("field {%s}: empty list separator", name)
+		}
+	case "enum":
+		if !hasArgument {
+			return part, fmt.Errorf("field {%s}: enum without alternatives", name)
+		}
+		part.alternatives = sortAlternatives(strings.Split(argument, "|"))
+	default:
+		return part, fmt.Errorf("field {%s}: unknown type %q", name, kind)
+	}
+	return part, nil
+}
+
+// sortAlternatives orders the alternatives from the longest to the shortest, so that the longest one matches first
+func sortAlternatives(alternatives []string) []string {
+	slices.SortStableFunc(alternatives, func(a, b string) int { return len(b) - len(a) })
+	return alternatives
+}
+
+// MustCompileScanner is like CompileScanner, but panics if the template is invalid
+func MustCompileScanner(template string) *Scanner {
+	scanner, err := CompileScanner(template)
+	if err != nil {
+		panic(err)
+	}
+	return scanner
+}
+
+// scanners caches the scanners compiled by Scan, by template
+var scanners sync.Map
+
+// Scan matches the line against the template, and fills the values of its fields
+// see Scanner for the template syntax and Scanner.Scan for the values filled
+func Scan(line, template string, values ...any) error {
+	scanner, found := scanners.Load(template)
+	if !found {
+		compiled, err := CompileScanner(template)
+		if err != nil {
+			return err
+		}
+		scanner, _ = scanners.LoadOrStore(template, compiled)
+	}
+	return scanner.(*Scanner).Scan(line, values...)
+}
+
+// ScanAll matches every line against the template, and returns the structures filled
+// errors are located at the line and column where the matching failed
+func ScanAll[T any](lines []string, template string) ([]T, error) {
+	scanner, err := CompileScanner(template)
+	if err != nil {
+		return nil, err
+	}
+	return MapLines(lines, func(line string) (value T, err error) {
+		err = scanner.Scan(line, &value)
+		return value, err
+	})
+}
+
+// MapLines returns the values returned by the parser for each line
+// errors are located at their line number, the column being kept for the errors of a scan
+func MapLines[T any](lines []string, parser func(string) (T, error)) ([]T, error) {
+	values := make([]T, len(lines))
+	for i, line := range lines {
+		value, err := parser(line)
+		if err != nil {
+			if located := (*ScanError)(nil); errors.As(err, &located) {
+				located.Line = i + 1
+				return values, err
+			}
+			return values, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// Scan matches the line against the template, and fills the values of its fields
+// values are either a single pointer to a structure, whose fields are filled by name (the "scan" tag,
+// else the field name, ignoring case), or a pointer per field in the template order
+// strings, integers and slices of them are supported
+func (scanner *Scanner) Scan(line string, values ...any) error {
+	targets, err := scanner.targets(values)
+	if err != nil {
+		return err
+	}
+
+	position, field := 0, 0
+	for i, part := range scanner.parts {
+		failed := func(format string, args ...any) error {
+			return &ScanError{Column: position + 1, Err: fmt.Errorf(format, args...)}
+		}
+
+		// literal text, or alternatives
+		if part.field == "" {
+			alternative, matched := matchAlternative(line[position:], part.alternatives)
+			if !matched {
+				return failed("expected %s, found %s", quoteAlternatives(part.alternatives), quoteRest(line[position:]))
+			}
+			position += len(alternative)
+			continue
+		}
+
+		var target reflect.Value
+		if part.field != "_" {
+			target = targets[field]
+			field++
+		}
+
+		// find the end of the text captured by the field
+		end := len(line)
+		switch {
+		case part.kind == "enum":
+			alternative, matched := matchAlternative(line[position:], part.alternatives)
+			if !matched {
+				return failed("{%s}: expected %s, found %s", part.field, quoteAlternatives(part.alternatives), quoteRest(line[position:]))
+			}
+			end = position + len(alternative)
+		case part.kind == "int" || (part.kind == "" && target.IsValid() && isInteger(target.Kind())):
+			length := scanInteger(line[position:])
+			if length == 0 {
+				return failed("{%s}: expected an integer, found %s", part.field, quoteRest(line[position:]))
+			}
+			end = position + length
+		case i+1 < len(scanner.parts):
+			found := -1
+			for _, alternative := range scanner.parts[i+1].alternatives {
+				if index := strings.Index(line[position:], alternative); index >= 0 && (found < 0 || index < found) {
+					found = index
+				}
+			}
+			if found < 0 {
+				return failed("{%s}: expected %s after it, found %s", part.field, quoteAlternatives(scanner.parts[i+1].alternatives), quoteRest(line[position:]))
+			}
+			end = position + found
+		}
+
+		if target.IsValid() {
+			if err := setScanned(target, line[position:end], part); err != nil {
+				return failed("{%s}: %v", part.field, err)
+			}
+		}
+		position = end
+	}
+
+	if position < len(line) {
+		return &ScanError{Column: position + 1, Err: fmt.Errorf("unexpected %s at the end of the line", quoteRest(line[position:]))}
+	}
+	return nil
+}
+
+// targets returns the values to fill, in the order of the template fields
+func (scanner *Scanner) targets(values []any) ([]reflect.Value, error) {
+	targets := make([]reflect.Value, 0, len(scanner.fields))
+
+	// a single structure, filled by field name
+	if len(values) == 1 {
+		if value := reflect.ValueOf(values[0]); value.Kind() == reflect.Pointer && value.Elem().Kind() == reflect.Struct {
+			structure := value.Elem()
+			// visible fields include the ones promoted from embedded structures, located by their index path
+			fields := reflect.VisibleFields(structure.Type())
+			for _, name := range scanner.fields {
+				index := slices.IndexFunc(fields, func(field reflect.StructField) bool {
+					if tag, found := field.Tag.Lookup("scan"); found {
+						return tag == name
+					}
+					return !field.Anonymous && strings.EqualFold(field.Name, name)
+				})
+				if index < 0 {
+					return nil, fmt.Errorf("template %q: no exported field %s in %v", scanner.template, name, structure.Type())
+				}
+				target, err := structure.FieldByIndexErr(fields[index].Index)
+				if err != nil || !target.CanSet() {
+					return nil, fmt.Errorf("template %q: field %s of %v cannot be set", scanner.template, name, structure.Type())
+				}
+				targets = append(targets, target)
+			}
+			return targets, nil
+		}
+	}
+
+	// a pointer per field
+	if len(values) != len(scanner.fields) {
+		return nil, fmt.Errorf("template %q: %d values given for %d fields", scanner.template, len(values), len(scanner.fields))
+	}
+	for i, v := range values {
+		value := reflect.ValueOf(v)
+		if value.Kind() != reflect.Pointer || value.IsNil() {
+			return nil, fmt.Errorf("template %q: value of field {%s} is not a pointer", scanner.template, scanner.fields[i])
+		}
+		targets = append(targets, value.Elem())
+	}
+	return targets, nil
+}
+
+// setScanned converts the text captured by the field to the type of the target, and sets it
+func setScanned(target reflect.Value, text string, part scanPart) error {
+	if part.kind == "list" || (part.kind == "" && target.Kind() == reflect.Slice) {
+		if target.Kind() != reflect.Slice {
+			return fmt.Errorf("a list cannot be stored in a %v", target.Type())
+		}
+		items := []string{}
+		if text != "" {
+			items = strings.Split(text, part.separator)
+		}
+		slice := reflect.MakeSlice(target.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := setScalar(slice.Index(i), item); err != nil {
+				return fmt.Errorf("item %d: %w", i+1, err)
+			}
+		}
+		target.Set(slice)
+		return nil
+	}
+	if part.kind == "int" && !isInteger(target.Kind()) {
+		return fmt.Errorf("an integer cannot be stored in a %v", target.Type())
+	}
+	return setScalar(target, text)
+}
+
+// setScalar converts the text to the type of the target, a string or an integer, and sets it
+func setScalar(target reflect.Value, text string) error {
+	switch kind := target.Kind(); {
+	case kind == reflect.String:
+		target.SetString(text)
+	case isInteger(kind) && kind >= reflect.Uint && kind <= reflect.Uint64:
+		value, err := strconv.ParseUint(text, 10, target.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid unsigned integer %q", text)
+		}
+		target.SetUint(value)
+	case isInteger(kind):
+		value, err := strconv.ParseInt(text, 10, target.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", text)
+		}
+		target.SetInt(value)
+	default:
+		return fmt.Errorf("unsupported type %v", target.Type())
+	}
+	return nil
+}
+
+// isInteger tells whether the kind is a signed or unsigned integer
+func isInteger(kind reflect.Kind) bool {
+	return (kind >= reflect.Int && kind <= reflect.Int64) || (kind >= reflect.Uint && kind <= reflect.Uint64)
+}
+
+// scanInteger returns the length of the integer, with an optional sign, at the start of the text
+func scanInteger(text string) int {
+	i := 0
+	if i < len(text) && (text[i] == '-' || text[i] == '+') {
+		i++
+	}
+	digits := i
+	for i < len(text) && text[i] >= '0' && text[i] <= '9' {
+		i++
+	}
+	if i == digits {
+		return 0
+	}
+	return i
+}
+
+// matchAlternative returns the first alternative the text starts with
+func matchAlternative(text string, alternatives []string) (string, bool) {
+	for _, alternative := range alternatives {
+		if strings.HasPrefix(text, alternative) {
+			return alternative, true
+		}
+	}
+	return "", false
+}
+
+// quoteAlternatives returns the alternatives quoted, for error messages
+func quoteAlternatives(alternatives []string) string {
+	return strings.Join(ArrayMap(alternatives, strconv.Quote), " or ")
+}
+
+// quoteRest returns the rest of a line quoted and shortened, for error messages
+func quoteRest(text string) string {
+	if text == "" {
+		return "the end of the line"
+	}
+	if len(text) > 20 {
+		text = text[:20] + "…"
+	}
+	return strconv.Quote(text)
+}
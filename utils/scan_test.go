@@ -0,0 +1,178 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestScan(t *testing.T) {
+	var (
+		name   string
+		number int
+		small  uint8
+		list   []string
+		ints   []int
+	)
+	tests := []struct {
+		line     string
+		template string
+		values   []any
+		want     string // values formatted after a successful scan
+		err      string
+	}{
+		// literals and alternatives
+		{"move up", "move [up|down]", nil, "", ""},
+		{"move down", "move [up|down]", nil, "", ""},
+		{"move left", "move [up|down]", nil, "", `column 6: expected "down" or "up", found "left"`},
+		{"{x}", "{{x}", nil, "", ""},
+		{"[x]", "[[x]", nil, "", ""},
+		{"valve", "valve[s|]", nil, "", ""},
+		{"valves", "valve[s|]", nil, "", ""},
+		{"move up!", "move up", nil, "", `column 8: unexpected "!" at the end of the line`},
+
+		// typed captures
+		{"dir a.txt", "dir {name}", []any{&name}, "a.txt", ""},
+		{"14848514 b.txt", "{size} {name}", []any{&number, &name}, "14848514 b.txt", ""},
+		{"x=-12, y=3", "x={x:int}, y={_}", []any{&number}, "-12", ""},
+		{"x=ab, y=3", "x={x:int}, y={_}", []any{&number}, "", `column 3: {x}: expected an integer, found "ab, y=3"`},
+		{"255", "{n}", []any{&small}, "255", ""},
+		{"256", "{n}", []any{&small}, "", `column 1: {n}: invalid unsigned integer "256"`},
+		{"%ab", "{kind:enum(%|&|)}{name}", []any{&name, new(string)}, "% ab", ""},
+		{"?ab", "{kind:enum(%|&)}{name}", []any{&name, new(string)}, "", `column 1: {kind}: expected "%" or "&", found "?ab"`},
+		{"a -> b", "{from} -> {to}", []any{&name}, "", `template "{from} -> {to}": 1 values given for 2 fields`},
+		{"a -> b", "{from} -> {to}", []any{name, &name}, "", `template "{from} -> {to}": value of field {from} is not a pointer`},
+		{"a b", "{from} -> {to}", []any{&name, &name}, "", `column 1: {from}: expected " -> " after it, found "a b"`},
+
+		// slices
+		{"to a, b, c", "to {next:list}", []any{&list}, "[a b c]", ""},
+		{"to a", "to {next}", []any{&list}, "[a]", ""},
+		{"to ", "to {next}", []any{&list}, "[]", ""},
+		{"1-2-3.", "{n:list(-)}.", []any{&ints}, "[1 2 3]", ""},
+		{"1-x-3.", "{n:list(-)}.", []any{&ints}, "", `column 1: {n}: item 2: invalid integer "x"`},
+		{"a, b", "{n:list}", []any{&number}, "", `column 1: {n}: a list cannot be stored in a int`},
+	}
+	for _, test := range tests {
+		err := Scan(test.line, test.template, test.values...)
+		if test.err != "" {
+			if err == nil || err.Error() != test.err {
+				t.Errorf("Scan(%q, %q) error = %v, want %s", test.line, test.template, err, test.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Scan(%q, %q) error = %v", test.line, test.template, err)
+			continue
+		}
+		if got := formatScanned(test.values); got != test.want {
+			t.Errorf("Scan(%q, %q) = %s, want %s", test.line, test.template, got, test.want)
+		}
+	}
+}
+
+// formatScanned returns the values pointed to, separated by spaces
+func formatScanned(values []any) string {
+	texts := make([]string, len(values))
+	for i, value := range values {
+		texts[i] = fmt.Sprint(reflect.ValueOf(value).Elem())
+	}
+	return strings.Join(texts, " ")
+}
+
+type scanBase struct {
+	ID   int
+	Name string `scan:"label"`
+}
+
+type scanValve struct {
+	Flow int
+	scanBase
+	Next    []string
+	private string
+}
+
+func TestScanStruct(t *testing.T) {
+	template := "Valve {id} [[{label}] has flow rate={flow}; tunnels lead to {next:list}"
+	valves, err := ScanAll[scanValve]([]string{
+		"Valve 1 [AA] has flow rate=0; tunnels lead to DD, II, BB",
+		"Valve 2 [BB] has flow rate=13; tunnels lead to CC",
+	}, template)
+	if err != nil {
+		t.Fatalf("ScanAll() error = %v", err)
+	}
+	want := []scanValve{
+		{Flow: 0, scanBase: scanBase{ID: 1, Name: "AA"}, Next: []string{"DD", "II", "BB"}},
+		{Flow: 13, scanBase: scanBase{ID: 2, Name: "BB"}, Next: []string{"CC"}},
+	}
+	for i := range want {
+		if got := valves[i]; got.Flow != want[i].Flow || got.scanBase != want[i].scanBase || !slices.Equal(got.Next, want[i].Next) {
+			t.Errorf("ScanAll()[%d] = %+v, want %+v", i, got, want[i])
+		}
+	}
+
+	_, err = ScanAll[scanValve]([]string{
+		"Valve 1 [AA] has flow rate=0; tunnels lead to DD",
+		"Valve 2 [BB] has flow rate=x; tunnels lead to CC",
+	}, template)
+	if located := (*ScanError)(nil); !errors.As(err, &located) || err.Error() != `line 2 column 28: {flow}: expected an integer, found "x; tunnels lead to C…"` {
+		t.Errorf("ScanAll() error = %v", err)
+	}
+
+	var valve scanValve
+	if err := Scan("a", "{private}", &valve); err == nil || err.Error() != `template "{private}": field private of utils.scanValve cannot be set` {
+		t.Errorf("Scan() of an unexported field error = %v", err)
+	}
+	if err := Scan("a", "{missing}", &valve); err == nil || err.Error() != `template "{missing}": no exported field missing in utils.scanValve` {
+		t.Errorf("Scan() of a missing field error = %v", err)
+	}
+	if err := Scan("a", "{scanBase}", &valve); err == nil {
+		t.Errorf("Scan() of an embedded structure succeeded")
+	}
+}
+
+func TestMapLines(t *testing.T) {
+	parsePoint := func(line string) (point Location2D[int], err error) {
+		if line == "origin" {
+			return point, errors.New("not a point")
+		}
+		err = Scan(line, "{x},{y}", &point.X, &point.Y)
+		return point, err
+	}
+
+	points, err := MapLines([]string{"1,2", "-3,4"}, parsePoint)
+	if want := []Location2D[int]{NewLocation2D(1, 2), NewLocation2D(-3, 4)}; err != nil || !slices.Equal(points, want) {
+		t.Errorf("MapLines() = %v, %v, want %v", points, err, want)
+	}
+
+	// scan errors keep their column, other errors are prefixed by the line
+	if _, err := MapLines([]string{"1,2", "3;4"}, parsePoint); err == nil || err.Error() != `line 2 column 2: expected ",", found ";4"` {
+		t.Errorf("MapLines() error = %v", err)
+	}
+	if _, err := MapLines([]string{"1,2", "3,4", "origin"}, parsePoint); err == nil || err.Error() != "line 3: not a point" {
+		t.Errorf("MapLines() error = %v", err)
+	}
+}
+
+func TestCompileScanner(t *testing.T) {
+	tests := []struct {
+		template string
+		err      string
+	}{
+		{"a {b", `template "a {b" column 3: unclosed '{'`},
+		{"{}", `template "{}" column 1: field without name`},
+		{"{a:float}", `template "{a:float}" column 1: field {a}: unknown type "float"`},
+		{"{a:list()}", `template "{a:list()}" column 1: field {a}: empty list separator`},
+		{"{a:enum}", `template "{a:enum}" column 1: field {a}: enum without alternatives`},
+		{"{a}{b}", `template "{a}{b}" column 1: field {a} is not followed by a literal text`},
+		{"{a:int}{b}", ""},
+	}
+	for _, test := range tests {
+		_, err := CompileScanner(test.template)
+		if (test.err == "" && err != nil) || (test.err != "" && (err == nil || err.Error() != test.err)) {
+			t.Errorf("CompileScanner(%q) error = %v, want %q", test.template, err, test.err)
+		}
+	}
+}
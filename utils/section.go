@@ -0,0 +1,123 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Section is a block of consecutive non-blank lines of an input, sections being separated by blank lines
+type Section struct {
+	Name  string // name given to the section, empty if unnamed
+	Index int    // index of the section in the input, starting at 0
+	Line  int    // line number of the first line of the section in the input, starting at 1
+	Lines []string
+}
+
+// Label returns the name of the section if any, else its position
+func (section Section) Label() string {
+	if section.Name != "" {
+		return fmt.Sprintf("%q", section.Name)
+	}
+	return fmt.Sprintf("#%d", section.Index+1)
+}
+
+// Errorf returns an error located at the line i of the section, starting at 0
+func (section Section) Errorf(i int, format string, args ...any) error {
+	return &SectionError{Section: section.Label(), Line: section.Line + i, Err: fmt.Errorf(format, args...)}
+}
+
+// SectionError reports an error in a section, located at a line of the input
+type SectionError struct {
+	Section string
+	Line    int
+	Err     error
+}
+
+// Error returns the error message, prefixed by its location
+func (err *SectionError) Error() string {
+	return fmt.Sprintf("section %s line %d: %v", err.Section, err.Line, err.Err)
+}
+
+// Unwrap returns the underlying error
+func (err *SectionError) Unwrap() error {
+	return err.Err
+}
+
+// Sections is the list of sections of an input
+type Sections []Section
+
+// SplitSections returns the sections of the lines, separated by one or more blank lines
+// the first sections are named after the names given, in order, and it is an error to have fewer sections than names
+func SplitSections(lines []string, names ...string) (Sections, error) {
+	sections := Sections{}
+	for i := 0; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == "" {
+			continue
+		}
+		start := i
+		for i < len(lines) && strings.TrimSpace(lines[i]) != "" {
+			i++
+		}
+		sections = append(sections, Section{Index: len(sections), Line: start + 1, Lines: lines[start:i]})
+	}
+
+	if len(sections) < len(names) {
+		return sections, fmt.Errorf("%d section(s) found, expected %d: %s", len(sections), len(names), strings.Join(names, ", "))
+	}
+	for i, name := range names {
+		sections[i].Name = name
+	}
+	return sections, nil
+}
+
+// Get returns the section having the name, an empty section if there is none
+func (sections Sections) Get(name string) Section {
+	for _, section := range sections {
+		if section.Name == name {
+			return section
+		}
+	}
+	return Section{Name: name}
+}
+
+// MapSections returns the values returned by the parser for each section of the lines
+// errors not already located by Section.Errorf are reported at the first line of the section
+func MapSections[T any](lines []string, parser func(Section) (T, error)) ([]T, error) {
+	sections, err := SplitSections(lines)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSections(sections, parser)
+}
+
+// ParseSections returns the values returned by the parser for each of the sections
+// errors not already located by Section.Errorf are reported at the first line of the section
+func ParseSections[T any](sections Sections, parser func(Section) (T, error)) ([]T, error) {
+	values := make([]T, len(sections))
+	for i, section := range sections {
+		value, err := parser(section)
+		if err != nil {
+			return values, section.wrap(err)
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// wrap locates the error at the first line of the section, unless it is already located
+func (section Section) wrap(err error) error {
+	if located := (*SectionError)(nil); errors.As(err, &located) {
+		return err
+	}
+	return &SectionError{Section: section.Label(), Line: section.Line, Err: err}
+}
+
+// Must returns the value, and panics if there is an error
+// parse functions having no way to return an error use it, the panic being reported as an error by the aoc command
+func Must[T any](value T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
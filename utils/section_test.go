@@ -0,0 +1,107 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestSplitSections(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  [][]string
+		first []int // line number of the first line of each section
+	}{
+		{"single blank lines", []string{"a", "b", "", "c", "", "d"}, [][]string{{"a", "b"}, {"c"}, {"d"}}, []int{1, 4, 6}},
+		{"several blank lines", []string{"a", "", "", "  ", "b"}, [][]string{{"a"}, {"b"}}, []int{1, 5}},
+		{"leading and trailing blanks", []string{"", " ", "a", "b", "", ""}, [][]string{{"a", "b"}}, []int{3}},
+		{"missing trailing separator", []string{"a", "", "b", "c"}, [][]string{{"a"}, {"b", "c"}}, []int{1, 3}},
+		{"blank input", []string{"", "\t"}, [][]string{}, []int{}},
+		{"no input", nil, [][]string{}, []int{}},
+	}
+	for _, test := range tests {
+		sections, err := SplitSections(test.lines)
+		if err != nil {
+			t.Errorf("%s: SplitSections() error = %v", test.name, err)
+			continue
+		}
+		lines := ArrayMap(sections, func(section Section) []string { return section.Lines })
+		first := ArrayMap(sections, func(section Section) int { return section.Line })
+		if !slices.EqualFunc(lines, test.want, slices.Equal[[]string]) || !slices.Equal(first, test.first) {
+			t.Errorf("%s: SplitSections() = %q at lines %v, want %q at lines %v", test.name, lines, first, test.want, test.first)
+		}
+		for i, section := range sections {
+			if section.Index != i {
+				t.Errorf("%s: section %d has index %d", test.name, i, section.Index)
+			}
+		}
+	}
+}
+
+func TestSectionNames(t *testing.T) {
+	lines := []string{"seeds: 1 2", "", "a", "b", "", "c"}
+	sections, err := SplitSections(lines, "seeds", "first")
+	if err != nil {
+		t.Fatalf("SplitSections() error = %v", err)
+	}
+	if seeds := sections.Get("seeds"); !slices.Equal(seeds.Lines, []string{"seeds: 1 2"}) || seeds.Label() != `"seeds"` {
+		t.Errorf(`Get("seeds") = %+v`, seeds)
+	}
+	if first := sections.Get("first"); !slices.Equal(first.Lines, []string{"a", "b"}) {
+		t.Errorf(`Get("first") = %+v`, first)
+	}
+	if missing := sections.Get("missing"); missing.Lines != nil || missing.Name != "missing" {
+		t.Errorf(`Get("missing") = %+v`, missing)
+	}
+	if label := sections[2].Label(); label != "#3" {
+		t.Errorf("Label() of an unnamed section = %s", label)
+	}
+
+	if _, err := SplitSections(lines[:1], "seeds", "first"); err == nil || err.Error() != "1 section(s) found, expected 2: seeds, first" {
+		t.Errorf("SplitSections() with a missing section error = %v", err)
+	}
+}
+
+func TestMapSections(t *testing.T) {
+	sum := func(section Section) (int, error) {
+		total := 0
+		for i, line := range section.Lines {
+			if line == "?" {
+				return 0, errors.New("unknown value")
+			}
+			value, err := strconv.Atoi(line)
+			if err != nil {
+				return 0, section.Errorf(i, "invalid value %q", line)
+			}
+			total += value
+		}
+		return total, nil
+	}
+
+	sums, err := MapSections([]string{"1", "2", "", "3", "", "", "4", "5"}, sum)
+	if err != nil || !slices.Equal(sums, []int{3, 3, 9}) {
+		t.Errorf("MapSections() = %v, %v", sums, err)
+	}
+
+	// errors are located at their line, or at the first line of the section
+	tests := []struct {
+		lines []string
+		err   string
+	}{
+		{[]string{"1", "", "2", "x"}, `section #2 line 4: invalid value "x"`},
+		{[]string{"1", "", "", "2", "?"}, "section #2 line 4: unknown value"},
+	}
+	for _, test := range tests {
+		_, err := MapSections(test.lines, sum)
+		if located := (*SectionError)(nil); !errors.As(err, &located) || err.Error() != test.err {
+			t.Errorf("MapSections(%q) error = %v, want %s", test.lines, err, test.err)
+		}
+	}
+
+	if err := fmt.Errorf("parse: %w", Section{Name: "items", Line: 7}.Errorf(2, "bad")); err.Error() != `parse: section "items" line 9: bad` {
+		t.Errorf("Errorf() = %v", err)
+	}
+}
@@ -0,0 +1,150 @@
+package utils
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Set implements an unordered set of comparable elements
+// being a map, it can be iterated with range, and its zero value must be created with NewSet or make before adding
+type Set[T comparable] map[T]struct{}
+
+// NewSet is a quick way to get a Set containing the list of elements
+func NewSet[T comparable](elems ...T) Set[T] {
+	set := make(Set[T], len(elems))
+	set.Add(elems...)
+	return set
+}
+
+// Add inserts the elements
+func (set Set[T]) Add(elems ...T) {
+	for _, elem := range elems {
+		set[elem] = struct{}{}
+	}
+}
+
+// Has tells whether the element is in the set
+func (set Set[T]) Has(elem T) bool {
+	_, found := set[elem]
+	return found
+}
+
+// Remove removes the elements
+func (set Set[T]) Remove(elems ...T) {
+	for _, elem := range elems {
+		delete(set, elem)
+	}
+}
+
+// Len returns the number of elements
+func (set Set[T]) Len() int {
+	return len(set)
+}
+
+// Clone returns a copy of the set
+func (set Set[T]) Clone() Set[T] {
+	clone := make(Set[T], len(set))
+	for elem := range set {
+		clone[elem] = struct{}{}
+	}
+	return clone
+}
+
+// Each calls the callback function for every element, in no particular order
+func (set Set[T]) Each(callback func(T)) {
+	for elem := range set {
+		callback(elem)
+	}
+}
+
+// Slice returns the elements, in no particular order
+func (set Set[T]) Slice() []T {
+	elems := make([]T, 0, len(set))
+	for elem := range set {
+		elems = append(elems, elem)
+	}
+	return elems
+}
+
+// Union returns a new set of the elements in the set or in any of the others
+func (set Set[T]) Union(others ...Set[T]) Set[T] {
+	union := set.Clone()
+	for _, other := range others {
+		for elem := range other {
+			union[elem] = struct{}{}
+		}
+	}
+	return union
+}
+
+// Intersection returns a new set of the elements in the set and in all of the others
+func (set Set[T]) Intersection(others ...Set[T]) Set[T] {
+	intersection := make(Set[T])
+	for elem := range set {
+		if slices.IndexFunc(others, func(other Set[T]) bool { return !other.Has(elem) }) < 0 {
+			intersection[elem] = struct{}{}
+		}
+	}
+	return intersection
+}
+
+// Difference returns a new set of the elements in the set, but in none of the others
+func (set Set[T]) Difference(others ...Set[T]) Set[T] {
+	difference := make(Set[T])
+	for elem := range set {
+		if slices.IndexFunc(others, func(other Set[T]) bool { return other.Has(elem) }) < 0 {
+			difference[elem] = struct{}{}
+		}
+	}
+	return difference
+}
+
+// SymmetricDifference returns a new set of the elements in either the set or the other, but not in both
+func (set Set[T]) SymmetricDifference(other Set[T]) Set[T] {
+	return set.Difference(other).Union(other.Difference(set))
+}
+
+// IsSubsetOf tells whether all the elements of the set are in the other
+func (set Set[T]) IsSubsetOf(other Set[T]) bool {
+	if len(set) > len(other) {
+		return false
+	}
+	for elem := range set {
+		if !other.Has(elem) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf tells whether all the elements of the other are in the set
+func (set Set[T]) IsSupersetOf(other Set[T]) bool {
+	return other.IsSubsetOf(set)
+}
+
+// Equal tells whether both sets have the same elements
+func (set Set[T]) Equal(other Set[T]) bool {
+	return len(set) == len(other) && set.IsSubsetOf(other)
+}
+
+// Intersection returns a new set of the elements present in all the sets, an empty set if there is none
+func Intersection[T comparable](sets ...Set[T]) Set[T] {
+	if len(sets) == 0 {
+		return make(Set[T])
+	}
+	return sets[0].Intersection(sets[1:]...)
+}
+
+// Sorted returns the elements of the set in ascending order
+func Sorted[T cmp.Ordered](set Set[T]) []T {
+	elems := set.Slice()
+	slices.Sort(elems)
+	return elems
+}
+
+// SortedFunc returns the elements of the set ordered by the comparison function
+func SortedFunc[T comparable](set Set[T], compare func(a, b T) int) []T {
+	elems := set.Slice()
+	slices.SortFunc(elems, compare)
+	return elems
+}
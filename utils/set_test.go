@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSet(t *testing.T) {
+	a, b := NewSet(1, 2, 3, 4), NewSet(3, 4, 5)
+
+	tests := []struct {
+		name     string
+		set      Set[int]
+		expected []int
+	}{
+		{"union", a.Union(b), []int{1, 2, 3, 4, 5}},
+		{"intersection", a.Intersection(b), []int{3, 4}},
+		{"intersection of none", Intersection[int](), []int{}},
+		{"difference", a.Difference(b), []int{1, 2}},
+		{"symmetric difference", a.SymmetricDifference(b), []int{1, 2, 5}},
+	}
+	for _, test := range tests {
+		if sorted := Sorted(test.set); !slices.Equal(sorted, test.expected) {
+			t.Errorf("%s = %v, want %v", test.name, sorted, test.expected)
+		}
+	}
+
+	clone := a.Clone()
+	clone.Remove(1, 2)
+	clone.Add(6)
+	if !a.Has(1) || a.Has(6) || clone.Len() != 3 {
+		t.Errorf("Clone() shares the storage of the set")
+	}
+	if !NewSet(3, 4).IsSubsetOf(a) || a.IsSubsetOf(b) || !a.IsSupersetOf(NewSet(1)) || !a.Equal(NewSet(4, 3, 2, 1)) {
+		t.Errorf("subset tests failed")
+	}
+}
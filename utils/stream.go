@@ -0,0 +1,125 @@
+package utils
+
+import (
+	"bufio"
+	"errors"
+	"io"
+)
+
+// ErrLineTooLong is reported by LineReader for lines longer than its MaxLength
+var ErrLineTooLong = errors.New("line too long")
+
+// LineReader iterates over the lines of a reader, without the line length limit of bufio.Scanner
+// line breaks ("\n" or "\r\n") are not part of the lines, and a final line break does not start an empty line
+//
+//	lines := NewLineReader(reader)
+//	for lines.Next() {
+//		fmt.Println(lines.Line(), lines.Text())
+//	}
+//	if err := lines.Err(); err != nil {
+type LineReader struct {
+	MaxLength int // maximum length of a line in bytes, unlimited when 0
+
+	reader *bufio.Reader
+	buffer []byte
+	line   int
+	err    error
+}
+
+// NewLineReader is a quick way to get a LineReader of the reader, with unlimited line length
+func NewLineReader(reader io.Reader) *LineReader {
+	buffered, ok := reader.(*bufio.Reader)
+	if !ok {
+		buffered = bufio.NewReader(reader)
+	}
+	return &LineReader{reader: buffered}
+}
+
+// Next reads the next line, and returns false at the end of the input or on error
+func (lines *LineReader) Next() bool {
+	if lines.err != nil {
+		return false
+	}
+
+	lines.buffer = lines.buffer[:0]
+	for {
+		chunk, err := lines.reader.ReadSlice('\n')
+		lines.buffer = append(lines.buffer, chunk...)
+		if lines.MaxLength > 0 && len(lines.buffer) > lines.MaxLength+len("\r\n") {
+			lines.err = &ScanError{Line: lines.line + 1, Column: lines.MaxLength + 1, Err: ErrLineTooLong}
+			return false
+		}
+
+		if errors.Is(err, bufio.ErrBufferFull) {
+			continue
+		}
+		if errors.Is(err, io.EOF) && len(lines.buffer) == 0 {
+			return false
+		}
+		if err != nil && !errors.Is(err, io.EOF) {
+			lines.err = err
+			return false
+		}
+		break
+	}
+
+	lines.line++
+	lines.buffer = dropLineBreak(lines.buffer)
+	if lines.MaxLength > 0 && len(lines.buffer) > lines.MaxLength {
+		lines.err = &ScanError{Line: lines.line, Column: lines.MaxLength + 1, Err: ErrLineTooLong}
+		return false
+	}
+	return true
+}
+
+// dropLineBreak removes the line break at the end of the line, if any
+func dropLineBreak(line []byte) []byte {
+	if n := len(line); n > 0 && line[n-1] == '\n' {
+		line = line[:n-1]
+	}
+	if n := len(line); n > 0 && line[n-1] == '\r' {
+		line = line[:n-1]
+	}
+	return line
+}
+
+// Bytes returns the current line, the slice is only valid until the next call to Next
+func (lines *LineReader) Bytes() []byte {
+	return lines.buffer
+}
+
+// Text returns a copy of the current line
+func (lines *LineReader) Text() string {
+	return string(lines.buffer)
+}
+
+// Line returns the number of the current line, starting at 1
+func (lines *LineReader) Line() int {
+	return lines.line
+}
+
+// Err returns the error that stopped the iteration, nil at the end of the input
+func (lines *LineReader) Err() error {
+	return lines.err
+}
+
+// InputReader streams the input of a puzzle part, as bytes, runes or lines
+type InputReader struct {
+	Name string // input name (file name without extension)
+	*bufio.Reader
+
+	closer io.Closer
+}
+
+// Lines returns a LineReader over the rest of the input
+func (input *InputReader) Lines() *LineReader {
+	return NewLineReader(input.Reader)
+}
+
+// Close closes the underlying file, if any
+func (input *InputReader) Close() error {
+	if input.closer == nil {
+		return nil
+	}
+	return input.closer.Close()
+}
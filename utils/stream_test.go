@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLineReader(t *testing.T) {
+	long := strings.Repeat("x", 200_000) // longer than the bufio.Scanner token limit
+	lines, err := ReadLinesFrom(strings.NewReader("a\r\n\n" + long + "\nb"))
+	if err != nil || !slices.Equal(lines, []string{"a", "", long, "b"}) {
+		t.Errorf("ReadLinesFrom() = %d lines, %v", len(lines), err)
+	}
+
+	reader := NewLineReader(strings.NewReader("abc\nabcdef\n"))
+	reader.MaxLength = 4
+	for reader.Next() {
+		if reader.Text() != "abc" {
+			t.Errorf("line %d = %q, want abc", reader.Line(), reader.Text())
+		}
+	}
+	if err := reader.Err(); !errors.Is(err, ErrLineTooLong) || err.Error() != "line 2 column 5: line too long" {
+		t.Errorf("Err() = %v, want line 2 too long", err)
+	}
+}
+
+func TestReadRaw(t *testing.T) {
+	inputs := &Inputs{Source: SourceStdin, Stdin: strings.NewReader("1,2,3\n")}
+	if _, content, err := inputs.ReadRaw(2023, 15, 1); content != "1,2,3" || err != nil {
+		t.Errorf("ReadRaw() = %q, %v", content, err)
+	}
+	if _, lines, err := inputs.Read(2023, 15, 2); !slices.Equal(lines, []string{"1,2,3"}) || err != nil {
+		t.Errorf("Read() = %q, %v, stdin must be shared by both parts", lines, err)
+	}
+}
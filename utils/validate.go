@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Check validates the lines of an input, errors being ScanError located at the offending line and column
+// checks are declared by the parse functions of the days through Validate
+type Check func(lines []string) error
+
+// Validate normalizes the lines, then runs the checks in order, and returns the normalized lines or the first error
+//
+//	lines = Must(Validate(lines, Rectangular(), Alphabet(".#S"), ExactlyOne('S')))
+func Validate(lines []string, checks ...Check) ([]string, error) {
+	lines = Normalize(lines)
+	for _, check := range checks {
+		if err := check(lines); err != nil {
+			return lines, err
+		}
+	}
+	return lines, nil
+}
+
+// Normalize returns the lines without carriage returns and blanks at their end, and without the blank lines ending the input
+// editors and CRLF line endings would otherwise add unexpected characters to grids
+func Normalize(lines []string) []string {
+	normalized := make([]string, len(lines))
+	for i, line := range lines {
+		normalized[i] = strings.TrimRight(line, " \t\r")
+	}
+	for len(normalized) > 0 && normalized[len(normalized)-1] == "" {
+		normalized = normalized[:len(normalized)-1]
+	}
+	return normalized
+}
+
+// NonEmpty checks that there is at least one line
+func NonEmpty() Check {
+	return func(lines []string) error {
+		if len(lines) == 0 {
+			return &ScanError{Line: 1, Column: 1, Err: fmt.Errorf("empty input")}
+		}
+		return nil
+	}
+}
+
+// Rectangular checks that there is at least one line, and that all the lines have the same length
+func Rectangular() Check {
+	return func(lines []string) error {
+		if err := NonEmpty()(lines); err != nil {
+			return err
+		}
+		for i, line := range lines {
+			if len(line) != len(lines[0]) {
+				return &ScanError{Line: i + 1, Column: min(len(line), len(lines[0])) + 1,
+					Err: fmt.Errorf("line of length %d, expected %d as the first line", len(line), len(lines[0]))}
+			}
+		}
+		return nil
+	}
+}
+
+// Square checks that the lines are rectangular, with as many lines as columns
+func Square() Check {
+	return func(lines []string) error {
+		if err := Rectangular()(lines); err != nil {
+			return err
+		}
+		if len(lines) != len(lines[0]) {
+			return &ScanError{Line: min(len(lines), len(lines[0])) + 1, Column: 1,
+				Err: fmt.Errorf("%d lines, expected %d for a square", len(lines), len(lines[0]))}
+		}
+		return nil
+	}
+}
+
+// Alphabet checks that the lines only contain the allowed characters
+// the lines are read as UTF-8, an unexpected character being located at its column counted in characters
+func Alphabet(allowed string) Check {
+	return func(lines []string) error {
+		for i, line := range lines {
+			column := 0
+			for _, r := range line {
+				column++
+				if !strings.ContainsRune(allowed, r) {
+					return &ScanError{Line: i + 1, Column: column, Err: fmt.Errorf("unexpected %q, expected one of %q", r, allowed)}
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// ExactlyOne checks that the character appears once and only once in the lines
+// a missing character is reported at the first line, as it could be anywhere
+func ExactlyOne(c byte) Check {
+	return func(lines []string) error {
+		found := false
+		for i, line := range lines {
+			for x := 0; x < len(line); x++ {
+				if line[x] != c {
+					continue
+				}
+				if found {
+					return &ScanError{Line: i + 1, Column: x + 1, Err: fmt.Errorf("more than one %q", c)}
+				}
+				found = true
+			}
+		}
+		if !found {
+			return &ScanError{Line: 1, Column: 1, Err: fmt.Errorf("no %q found", c)}
+		}
+		return nil
+	}
+}
+
+// NonEmptySections checks that the lines hold at least as many sections as names, separated by blank lines
+// it reports the first missing section by its name
+func NonEmptySections(names ...string) Check {
+	return func(lines []string) error {
+		sections, _ := SplitSections(lines)
+		if len(sections) < len(names) {
+			return &ScanError{Line: len(lines) + 1, Column: 1,
+				Err: fmt.Errorf("missing section %q, %d section(s) found, expected %d", names[len(sections)], len(sections), len(names))}
+		}
+		return nil
+	}
+}
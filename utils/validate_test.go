@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	lines, err := Validate([]string{"S.#\r", ".#. ", "", ""}, Rectangular(), Alphabet(".#S"), ExactlyOne('S'))
+	if err != nil || !slices.Equal(lines, []string{"S.#", ".#."}) {
+		t.Errorf("Validate() = %q, %v", lines, err)
+	}
+
+	tests := []struct {
+		lines []string
+		check Check
+		err   string
+	}{
+		{nil, NonEmpty(), "line 1 column 1: empty input"},
+		{[]string{"abc", "ab"}, Rectangular(), "line 2 column 3: line of length 2, expected 3 as the first line"},
+		{[]string{"abc", "abc"}, Square(), "line 3 column 1: 2 lines, expected 3 for a square"},
+		{[]string{"..", ".x"}, Alphabet(".#"), `line 2 column 2: unexpected 'x', expected one of ".#"`},
+		{[]string{"é.", "éé", "é.x"}, Alphabet(".é"), `line 3 column 3: unexpected 'x', expected one of ".é"`},
+		{[]string{"..", ".é"}, Alphabet(".#"), `line 2 column 2: unexpected 'é', expected one of ".#"`},
+		{[]string{"S.", ".S"}, ExactlyOne('S'), "line 2 column 2: more than one 'S'"},
+		{[]string{"..", ".."}, ExactlyOne('S'), "line 1 column 1: no 'S' found"},
+		{[]string{"abc\r", "ab\r"}, Rectangular(), "line 2 column 3: line of length 2, expected 3 as the first line"},
+		{[]string{"", " ", "\r"}, NonEmpty(), "line 1 column 1: empty input"},
+		{[]string{"a", "", "b"}, NonEmptySections("a", "b", "c"), `line 4 column 1: missing section "c", 2 section(s) found, expected 3`},
+	}
+	for _, test := range tests {
+		_, err := Validate(test.lines, test.check)
+		if located := (*ScanError)(nil); !errors.As(err, &located) || err.Error() != test.err {
+			t.Errorf("Validate(%q) error = %v, want %s", test.lines, err, test.err)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		lines, want []string
+	}{
+		{[]string{"ab\r", "cd\r"}, []string{"ab", "cd"}},                    // CRLF line endings
+		{[]string{"ab \t", "cd\t \r"}, []string{"ab", "cd"}},                // trailing blanks
+		{[]string{" ab", "\tcd"}, []string{" ab", "\tcd"}},                  // leading blanks are kept
+		{[]string{"ab", "", "cd", "", " ", "\r"}, []string{"ab", "", "cd"}}, // blank lines, kept inside
+		{[]string{"", "\r"}, []string{}},
+	}
+	for _, test := range tests {
+		if lines := Normalize(test.lines); !slices.Equal(lines, test.want) {
+			t.Errorf("Normalize(%q) = %q, want %q", test.lines, lines, test.want)
+		}
+	}
+}